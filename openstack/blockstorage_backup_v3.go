@@ -0,0 +1,72 @@
+package openstack
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/samuelbernardolip/gophercloud"
+	"github.com/samuelbernardolip/gophercloud/openstack/blockstorage/extensions/backups"
+)
+
+const (
+	// blockStorageBackupV3UpdateMicroversion is the first block storage API
+	// microversion that allows a backup to be updated.
+	blockStorageBackupV3UpdateMicroversion = "3.9"
+
+	// blockStorageBackupV3MetadataMicroversion is the first block storage API
+	// microversion that supports backup metadata.
+	blockStorageBackupV3MetadataMicroversion = "3.43"
+
+	// blockStorageVolumeV3BackupMicroversion is the first block storage API
+	// microversion that allows a volume to be created from a backup.
+	blockStorageVolumeV3BackupMicroversion = "3.47"
+)
+
+// blockStorageBackupV3UpdateOpts is a custom UpdateOpts struct to send the
+// metadata of a backup even when it is empty, so that it can be removed.
+type blockStorageBackupV3UpdateOpts struct {
+	backups.UpdateOpts
+	Metadata *map[string]string
+}
+
+// ToBackupUpdateMap casts an UpdateOpts struct to a map.
+// It overrides backups.ToBackupUpdateMap to always include the Metadata field
+// when it is set.
+func (opts blockStorageBackupV3UpdateOpts) ToBackupUpdateMap() (map[string]interface{}, error) {
+	b, err := opts.UpdateOpts.ToBackupUpdateMap()
+	if err != nil {
+		return nil, err
+	}
+
+	if opts.Metadata != nil {
+		metadata := make(map[string]interface{}, len(*opts.Metadata))
+		for k, v := range *opts.Metadata {
+			metadata[k] = v
+		}
+		b["backup"].(map[string]interface{})["metadata"] = metadata
+	}
+
+	return b, nil
+}
+
+// blockStorageBackupV3StateRefreshFunc returns a resource.StateRefreshFunc
+// that is used to watch an OpenStack block storage backup.
+func blockStorageBackupV3StateRefreshFunc(client *gophercloud.ServiceClient, backupID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		b, err := backups.Get(client, backupID).Extract()
+		if err != nil {
+			if _, ok := err.(gophercloud.ErrDefault404); ok {
+				return b, "deleted", nil
+			}
+			return nil, "", err
+		}
+
+		if b.Status == "error" || b.Status == "error_deleting" || b.Status == "error_restoring" {
+			return b, b.Status, fmt.Errorf("The backup is in %s state: %s. "+
+				"Please check with your cloud admin or check the Block Storage "+
+				"API logs to see why this error occurred.", b.Status, b.FailReason)
+		}
+
+		return b, b.Status, nil
+	}
+}
@@ -0,0 +1,55 @@
+package openstack
+
+import (
+	"testing"
+
+	"github.com/samuelbernardolip/gophercloud/openstack/blockstorage/extensions/backups"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBlockStorageBackupV3UpdateOpts(t *testing.T) {
+	name := "backup_1"
+	metadata := map[string]string{
+		"foo": "bar",
+	}
+
+	opts := blockStorageBackupV3UpdateOpts{
+		UpdateOpts: backups.UpdateOpts{
+			Name: &name,
+		},
+		Metadata: &metadata,
+	}
+
+	expected := map[string]interface{}{
+		"backup": map[string]interface{}{
+			"name": "backup_1",
+			"metadata": map[string]interface{}{
+				"foo": "bar",
+			},
+		},
+	}
+
+	actual, err := opts.ToBackupUpdateMap()
+
+	assert.NoError(t, err)
+	assert.Equal(t, expected, actual)
+}
+
+func TestBlockStorageBackupV3UpdateOptsEmptyMetadata(t *testing.T) {
+	metadata := map[string]string{}
+
+	opts := blockStorageBackupV3UpdateOpts{
+		Metadata: &metadata,
+	}
+
+	expected := map[string]interface{}{
+		"backup": map[string]interface{}{
+			"metadata": map[string]interface{}{},
+		},
+	}
+
+	actual, err := opts.ToBackupUpdateMap()
+
+	assert.NoError(t, err)
+	assert.Equal(t, expected, actual)
+}
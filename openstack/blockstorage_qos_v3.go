@@ -0,0 +1,27 @@
+package openstack
+
+import (
+	"github.com/samuelbernardolip/gophercloud/openstack/blockstorage/v3/qos"
+)
+
+func expandBlockStorageQoSV3Specs(raw map[string]interface{}) map[string]string {
+	specs := make(map[string]string, len(raw))
+	for k, v := range raw {
+		specs[k] = v.(string)
+	}
+
+	return specs
+}
+
+// flattenBlockStorageQoSV3VolumeTypeIDs returns the IDs of the volume types
+// a QoS specification is associated with.
+func flattenBlockStorageQoSV3VolumeTypeIDs(associations []qos.QosAssociation) []string {
+	volumeTypeIDs := make([]string, 0, len(associations))
+	for _, association := range associations {
+		if association.AssociationType == "volume_type" {
+			volumeTypeIDs = append(volumeTypeIDs, association.ID)
+		}
+	}
+
+	return volumeTypeIDs
+}
@@ -0,0 +1,45 @@
+package openstack
+
+import (
+	"testing"
+
+	"github.com/samuelbernardolip/gophercloud/openstack/blockstorage/v3/qos"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExpandBlockStorageQoSV3Specs(t *testing.T) {
+	raw := map[string]interface{}{
+		"read_iops_sec":  "20000",
+		"write_iops_sec": "10000",
+	}
+
+	expected := map[string]string{
+		"read_iops_sec":  "20000",
+		"write_iops_sec": "10000",
+	}
+
+	actual := expandBlockStorageQoSV3Specs(raw)
+
+	assert.Equal(t, expected, actual)
+}
+
+func TestFlattenBlockStorageQoSV3VolumeTypeIDs(t *testing.T) {
+	associations := []qos.QosAssociation{
+		{
+			Name:            "type_1",
+			ID:              "type_id_1",
+			AssociationType: "volume_type",
+		},
+		{
+			Name:            "type_2",
+			ID:              "type_id_2",
+			AssociationType: "volume_type",
+		},
+	}
+
+	expected := []string{"type_id_1", "type_id_2"}
+
+	actual := flattenBlockStorageQoSV3VolumeTypeIDs(associations)
+
+	assert.Equal(t, expected, actual)
+}
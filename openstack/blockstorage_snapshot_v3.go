@@ -0,0 +1,77 @@
+package openstack
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/samuelbernardolip/gophercloud"
+	"github.com/samuelbernardolip/gophercloud/openstack/blockstorage/v3/snapshots"
+)
+
+// blockStorageSnapshotV3UpdateMetadataOpts represents the full set of metadata
+// of a snapshot. Unlike snapshots.UpdateMetadataOpts, an empty map is sent as
+// is, so that all metadata can be removed.
+type blockStorageSnapshotV3UpdateMetadataOpts struct {
+	Metadata map[string]string
+}
+
+// ToSnapshotUpdateMetadataMap assembles a request body based on the contents
+// of a blockStorageSnapshotV3UpdateMetadataOpts.
+func (opts blockStorageSnapshotV3UpdateMetadataOpts) ToSnapshotUpdateMetadataMap() (map[string]interface{}, error) {
+	metadata := make(map[string]interface{}, len(opts.Metadata))
+	for k, v := range opts.Metadata {
+		metadata[k] = v
+	}
+
+	return map[string]interface{}{"metadata": metadata}, nil
+}
+
+// blockStorageSnapshotV3UpdateOpts represents the attributes used when
+// updating a snapshot. The snapshots package can only update its metadata.
+type blockStorageSnapshotV3UpdateOpts struct {
+	Name        *string `json:"name,omitempty"`
+	Description *string `json:"description,omitempty"`
+}
+
+// ToSnapshotUpdateMap assembles a request body based on the contents of a
+// blockStorageSnapshotV3UpdateOpts.
+func (opts blockStorageSnapshotV3UpdateOpts) ToSnapshotUpdateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "snapshot")
+}
+
+// blockStorageSnapshotV3Update updates the name and description of a
+// snapshot.
+func blockStorageSnapshotV3Update(client *gophercloud.ServiceClient, id string, opts blockStorageSnapshotV3UpdateOpts) error {
+	b, err := opts.ToSnapshotUpdateMap()
+	if err != nil {
+		return err
+	}
+
+	_, err = client.Put(client.ServiceURL("snapshots", id), b, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+
+	return err
+}
+
+// blockStorageSnapshotV3StateRefreshFunc returns a resource.StateRefreshFunc
+// that is used to watch an OpenStack block storage snapshot.
+func blockStorageSnapshotV3StateRefreshFunc(client *gophercloud.ServiceClient, snapshotID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		s, err := snapshots.Get(client, snapshotID).Extract()
+		if err != nil {
+			if _, ok := err.(gophercloud.ErrDefault404); ok {
+				return s, "deleted", nil
+			}
+			return nil, "", err
+		}
+
+		if s.Status == "error" || s.Status == "error_deleting" {
+			return s, s.Status, fmt.Errorf("The snapshot is in %s state. "+
+				"Please check with your cloud admin or check the Block Storage "+
+				"API logs to see why this error occurred.", s.Status)
+		}
+
+		return s, s.Status, nil
+	}
+}
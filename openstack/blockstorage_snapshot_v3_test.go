@@ -0,0 +1,61 @@
+package openstack
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBlockStorageSnapshotV3UpdateOpts(t *testing.T) {
+	name := "snapshot_1"
+	description := ""
+
+	opts := blockStorageSnapshotV3UpdateOpts{
+		Name:        &name,
+		Description: &description,
+	}
+
+	expected := map[string]interface{}{
+		"snapshot": map[string]interface{}{
+			"name":        "snapshot_1",
+			"description": "",
+		},
+	}
+
+	actual, err := opts.ToSnapshotUpdateMap()
+
+	assert.NoError(t, err)
+	assert.Equal(t, expected, actual)
+}
+
+func TestBlockStorageSnapshotV3UpdateMetadataOpts(t *testing.T) {
+	opts := blockStorageSnapshotV3UpdateMetadataOpts{
+		Metadata: map[string]string{
+			"foo": "bar",
+		},
+	}
+
+	expected := map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"foo": "bar",
+		},
+	}
+
+	actual, err := opts.ToSnapshotUpdateMetadataMap()
+
+	assert.NoError(t, err)
+	assert.Equal(t, expected, actual)
+}
+
+func TestBlockStorageSnapshotV3UpdateMetadataOptsEmpty(t *testing.T) {
+	opts := blockStorageSnapshotV3UpdateMetadataOpts{}
+
+	expected := map[string]interface{}{
+		"metadata": map[string]interface{}{},
+	}
+
+	actual, err := opts.ToSnapshotUpdateMetadataMap()
+
+	assert.NoError(t, err)
+	assert.Equal(t, expected, actual)
+}
@@ -0,0 +1,39 @@
+package openstack
+
+import (
+	"sort"
+
+	"github.com/samuelbernardolip/gophercloud/openstack/blockstorage/v3/volumetypes"
+)
+
+func expandBlockStorageVolumeTypeV3ExtraSpecs(raw map[string]interface{}) volumetypes.ExtraSpecsOpts {
+	extraSpecs := make(volumetypes.ExtraSpecsOpts, len(raw))
+	for k, v := range raw {
+		extraSpecs[k] = v.(string)
+	}
+
+	return extraSpecs
+}
+
+// blockStorageV3RemovedKeys returns the sorted keys of a map attribute which
+// are no longer present after a change.
+func blockStorageV3RemovedKeys(oldRaw, newRaw map[string]interface{}) []string {
+	var keys []string
+	for k := range oldRaw {
+		if _, ok := newRaw[k]; !ok {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+
+	return keys
+}
+
+func flattenBlockStorageVolumeTypeV3Accesses(accesses []volumetypes.VolumeTypeAccess) []string {
+	projectIDs := make([]string, 0, len(accesses))
+	for _, access := range accesses {
+		projectIDs = append(projectIDs, access.ProjectID)
+	}
+
+	return projectIDs
+}
@@ -0,0 +1,58 @@
+package openstack
+
+import (
+	"testing"
+
+	"github.com/samuelbernardolip/gophercloud/openstack/blockstorage/v3/volumetypes"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExpandBlockStorageVolumeTypeV3ExtraSpecs(t *testing.T) {
+	raw := map[string]interface{}{
+		"volume_backend_name": "ssd",
+		"capabilities":        "gpu",
+	}
+
+	expected := volumetypes.ExtraSpecsOpts{
+		"volume_backend_name": "ssd",
+		"capabilities":        "gpu",
+	}
+
+	actual := expandBlockStorageVolumeTypeV3ExtraSpecs(raw)
+
+	assert.Equal(t, expected, actual)
+}
+
+func TestBlockStorageV3RemovedKeys(t *testing.T) {
+	oldRaw := map[string]interface{}{
+		"foo": "bar",
+		"baz": "qux",
+		"abc": "def",
+	}
+
+	newRaw := map[string]interface{}{
+		"foo": "changed",
+	}
+
+	assert.Equal(t, []string{"abc", "baz"}, blockStorageV3RemovedKeys(oldRaw, newRaw))
+	assert.Empty(t, blockStorageV3RemovedKeys(newRaw, oldRaw))
+}
+
+func TestFlattenBlockStorageVolumeTypeV3Accesses(t *testing.T) {
+	accesses := []volumetypes.VolumeTypeAccess{
+		{
+			VolumeTypeID: "type_1",
+			ProjectID:    "project_1",
+		},
+		{
+			VolumeTypeID: "type_1",
+			ProjectID:    "project_2",
+		},
+	}
+
+	expected := []string{"project_1", "project_2"}
+
+	actual := flattenBlockStorageVolumeTypeV3Accesses(accesses)
+
+	assert.Equal(t, expected, actual)
+}
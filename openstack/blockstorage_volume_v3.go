@@ -0,0 +1,54 @@
+package openstack
+
+import (
+	"github.com/samuelbernardolip/gophercloud"
+	"github.com/samuelbernardolip/gophercloud/openstack/blockstorage/v3/volumes"
+)
+
+// blockStorageVolumeV3CreateOpts is a custom CreateOpts struct to create a
+// volume from a backup.
+type blockStorageVolumeV3CreateOpts struct {
+	volumes.CreateOpts
+
+	// BackupID is the ID of the backup from which to create the volume.
+	// It requires blockStorageVolumeV3BackupMicroversion.
+	BackupID string `json:"backup_id,omitempty"`
+}
+
+// ToVolumeCreateMap casts a CreateOpts struct to a map.
+// It overrides volumes.ToVolumeCreateMap to add the BackupID field.
+func (opts blockStorageVolumeV3CreateOpts) ToVolumeCreateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "volume")
+}
+
+// blockStorageVolumeV3ChangeTypeOpts contains the options to change the type
+// of a volume with the os-retype action.
+type blockStorageVolumeV3ChangeTypeOpts struct {
+	// NewType is the name of the new volume type of the volume.
+	NewType string `json:"new_type" required:"true"`
+
+	// MigrationPolicy is either "never" or "on-demand". If it is not set,
+	// the Block Storage service doesn't migrate the volume.
+	MigrationPolicy string `json:"migration_policy,omitempty"`
+}
+
+// ToVolumeChangeTypeMap assembles a request body based on the contents of a
+// blockStorageVolumeV3ChangeTypeOpts.
+func (opts blockStorageVolumeV3ChangeTypeOpts) ToVolumeChangeTypeMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "os-retype")
+}
+
+// blockStorageVolumeV3ChangeType changes the type of a volume. This operation
+// does not return a response body.
+func blockStorageVolumeV3ChangeType(client *gophercloud.ServiceClient, id string, opts blockStorageVolumeV3ChangeTypeOpts) (r gophercloud.ErrResult) {
+	b, err := opts.ToVolumeChangeTypeMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+
+	_, r.Err = client.Post(client.ServiceURL("volumes", id, "action"), b, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{202},
+	})
+	return
+}
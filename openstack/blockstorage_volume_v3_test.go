@@ -0,0 +1,51 @@
+package openstack
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/samuelbernardolip/gophercloud/openstack/blockstorage/v3/volumes"
+)
+
+func TestBlockStorageVolumeV3CreateOpts(t *testing.T) {
+	opts := blockStorageVolumeV3CreateOpts{
+		CreateOpts: volumes.CreateOpts{
+			Name: "volume_1",
+			Size: 1,
+		},
+		BackupID: "backup_1",
+	}
+
+	expected := map[string]interface{}{
+		"volume": map[string]interface{}{
+			"name":      "volume_1",
+			"size":      float64(1),
+			"backup_id": "backup_1",
+		},
+	}
+
+	actual, err := opts.ToVolumeCreateMap()
+
+	assert.NoError(t, err)
+	assert.Equal(t, expected, actual)
+}
+
+func TestBlockStorageVolumeV3ChangeTypeOpts(t *testing.T) {
+	opts := blockStorageVolumeV3ChangeTypeOpts{
+		NewType:         "ssd",
+		MigrationPolicy: "on-demand",
+	}
+
+	expected := map[string]interface{}{
+		"os-retype": map[string]interface{}{
+			"new_type":         "ssd",
+			"migration_policy": "on-demand",
+		},
+	}
+
+	actual, err := opts.ToVolumeChangeTypeMap()
+
+	assert.NoError(t, err)
+	assert.Equal(t, expected, actual)
+}
@@ -0,0 +1,47 @@
+package openstack
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/samuelbernardolip/gophercloud/openstack/imageservice/v2/images"
+)
+
+// computeInstanceSnapshotV2VolumeSnapshotIDs returns the sorted IDs of the
+// block storage snapshots of a volume-backed instance snapshot. Nova stores
+// them in the block_device_mapping property of the image, either as a JSON
+// string or as a list.
+func computeInstanceSnapshotV2VolumeSnapshotIDs(img *images.Image) ([]string, error) {
+	var mappings []map[string]interface{}
+
+	switch v := img.Properties["block_device_mapping"].(type) {
+	case nil:
+		return nil, nil
+	case string:
+		if v == "" {
+			return nil, nil
+		}
+		if err := json.Unmarshal([]byte(v), &mappings); err != nil {
+			return nil, fmt.Errorf("Error parsing block_device_mapping of image %s: %s", img.ID, err)
+		}
+	case []interface{}:
+		for _, raw := range v {
+			if mapping, ok := raw.(map[string]interface{}); ok {
+				mappings = append(mappings, mapping)
+			}
+		}
+	default:
+		return nil, fmt.Errorf("Unknown type for block_device_mapping of image %s: %T", img.ID, v)
+	}
+
+	var snapshotIDs []string
+	for _, mapping := range mappings {
+		if id, ok := mapping["snapshot_id"].(string); ok && id != "" {
+			snapshotIDs = append(snapshotIDs, id)
+		}
+	}
+	sort.Strings(snapshotIDs)
+
+	return snapshotIDs, nil
+}
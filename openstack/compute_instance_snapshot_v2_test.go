@@ -0,0 +1,40 @@
+package openstack
+
+import (
+	"testing"
+
+	"github.com/samuelbernardolip/gophercloud/openstack/imageservice/v2/images"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestComputeInstanceSnapshotV2VolumeSnapshotIDs(t *testing.T) {
+	img := &images.Image{
+		ID: "image_1",
+		Properties: map[string]interface{}{
+			"block_device_mapping": `[{"boot_index": 0, "source_type": "snapshot", "snapshot_id": "snapshot_2"}, ` +
+				`{"boot_index": 1, "source_type": "snapshot", "snapshot_id": "snapshot_1"}, ` +
+				`{"boot_index": -1, "source_type": "blank", "snapshot_id": null}]`,
+		},
+	}
+
+	snapshotIDs, err := computeInstanceSnapshotV2VolumeSnapshotIDs(img)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"snapshot_1", "snapshot_2"}, snapshotIDs)
+
+	img.Properties["block_device_mapping"] = []interface{}{
+		map[string]interface{}{"source_type": "snapshot", "snapshot_id": "snapshot_1"},
+	}
+
+	snapshotIDs, err = computeInstanceSnapshotV2VolumeSnapshotIDs(img)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"snapshot_1"}, snapshotIDs)
+
+	img.Properties["block_device_mapping"] = "not json"
+
+	_, err = computeInstanceSnapshotV2VolumeSnapshotIDs(img)
+	assert.Error(t, err)
+
+	snapshotIDs, err = computeInstanceSnapshotV2VolumeSnapshotIDs(&images.Image{})
+	assert.NoError(t, err)
+	assert.Empty(t, snapshotIDs)
+}
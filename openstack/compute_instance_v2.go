@@ -0,0 +1,412 @@
+package openstack
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/samuelbernardolip/gophercloud"
+	"github.com/samuelbernardolip/gophercloud/openstack/blockstorage/v3/volumes"
+	"github.com/samuelbernardolip/gophercloud/openstack/compute/v2/extensions/pauseunpause"
+	"github.com/samuelbernardolip/gophercloud/openstack/compute/v2/extensions/rescueunrescue"
+	"github.com/samuelbernardolip/gophercloud/openstack/compute/v2/extensions/shelveunshelve"
+	"github.com/samuelbernardolip/gophercloud/openstack/compute/v2/extensions/startstop"
+	"github.com/samuelbernardolip/gophercloud/openstack/compute/v2/extensions/suspendresume"
+	"github.com/samuelbernardolip/gophercloud/openstack/compute/v2/servers"
+)
+
+// computeInstanceV2RebuildMicroversion is the first compute API microversion
+// that accepts both key_name (2.54) and user_data (2.57) in a rebuild request.
+const computeInstanceV2RebuildMicroversion = "2.57"
+
+// computeInstanceV2RebuildKeys are the attributes that can be applied to an
+// existing instance by rebuilding it when allow_rebuild is enabled.
+var computeInstanceV2RebuildKeys = []string{
+	"image_id",
+	"image_name",
+	"user_data",
+	"key_pair",
+}
+
+// ComputeInstanceV2RebuildOpts is a custom RebuildOpts struct to include the
+// KeyName and UserData fields.
+type ComputeInstanceV2RebuildOpts struct {
+	servers.RebuildOpts
+
+	// KeyName replaces the key pair of the server. An empty string removes
+	// the key pair. A nil value leaves it untouched.
+	KeyName *string
+
+	// UserData replaces the user data of the server. An empty string removes
+	// the user data. A nil value leaves it untouched.
+	UserData *string
+}
+
+// ToServerRebuildMap casts a RebuildOpts struct to a map.
+// It overrides servers.ToServerRebuildMap to add the KeyName and UserData fields.
+func (opts ComputeInstanceV2RebuildOpts) ToServerRebuildMap() (map[string]interface{}, error) {
+	b, err := opts.RebuildOpts.ToServerRebuildMap()
+	if err != nil {
+		return nil, err
+	}
+
+	rebuild := b["rebuild"].(map[string]interface{})
+
+	if opts.KeyName != nil {
+		if *opts.KeyName == "" {
+			rebuild["key_name"] = nil
+		} else {
+			rebuild["key_name"] = *opts.KeyName
+		}
+	}
+
+	if opts.UserData != nil {
+		if *opts.UserData == "" {
+			rebuild["user_data"] = nil
+		} else if _, err := base64.StdEncoding.DecodeString(*opts.UserData); err != nil {
+			rebuild["user_data"] = base64.StdEncoding.EncodeToString([]byte(*opts.UserData))
+		} else {
+			rebuild["user_data"] = *opts.UserData
+		}
+	}
+
+	return b, nil
+}
+
+// computeInstanceV2HasRebuildChange reports whether an attribute which is
+// applied through a rebuild has changed.
+func computeInstanceV2HasRebuildChange(d *schema.ResourceData) bool {
+	for _, key := range computeInstanceV2RebuildKeys {
+		if d.HasChange(key) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// computeInstanceV2CustomizeDiff forces a new instance when an attribute which
+// can only be changed through a rebuild is modified and allow_rebuild is
+// disabled.
+func computeInstanceV2CustomizeDiff(diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() == "" {
+		return nil
+	}
+
+	if !diff.Get("allow_rebuild").(bool) {
+		for _, key := range computeInstanceV2RebuildKeys {
+			if diff.HasChange(key) {
+				if err := diff.ForceNew(key); err != nil {
+					return err
+				}
+			}
+		}
+
+		return nil
+	}
+
+	// The image is rebuilt using whichever of image_id or image_name changed,
+	// so the other one can only be known after the rebuild.
+	if diff.HasChange("image_name") && !diff.HasChange("image_id") {
+		return diff.SetNewComputed("image_id")
+	}
+
+	if diff.HasChange("image_id") && !diff.HasChange("image_name") {
+		return diff.SetNewComputed("image_name")
+	}
+
+	return nil
+}
+
+// expandComputeInstanceV2RebuildOpts builds the rebuild options for an
+// instance. The key pair and user data are only sent when they have changed,
+// in which case the compute microversion that the request requires is
+// returned as well.
+func expandComputeInstanceV2RebuildOpts(d *schema.ResourceData, imageID string) (ComputeInstanceV2RebuildOpts, string) {
+	var microversion string
+	rebuildOpts := ComputeInstanceV2RebuildOpts{
+		RebuildOpts: servers.RebuildOpts{
+			ImageID:   imageID,
+			AdminPass: d.Get("admin_pass").(string),
+			Metadata:  resourceInstanceMetadataV2(d),
+		},
+	}
+
+	if d.HasChange("key_pair") {
+		keyName := d.Get("key_pair").(string)
+		rebuildOpts.KeyName = &keyName
+		microversion = computeInstanceV2RebuildMicroversion
+	}
+
+	if d.HasChange("user_data") {
+		userData := d.Get("user_data").(string)
+		rebuildOpts.UserData = &userData
+		microversion = computeInstanceV2RebuildMicroversion
+	}
+
+	return rebuildOpts, microversion
+}
+
+// computeInstanceV2PowerStateStatuses returns the server statuses which
+// satisfy a power_state. A shelved server may be offloaded by Nova at any time.
+func computeInstanceV2PowerStateStatuses(state string) []string {
+	if state == "shelved" {
+		return []string{"SHELVED", "SHELVED_OFFLOADED"}
+	}
+
+	return []string{strings.ToUpper(state)}
+}
+
+// computeInstanceV2SetPowerState moves an instance from its current
+// power_state to the target one. Unless the target can be reached directly,
+// the instance is brought back to active first.
+func computeInstanceV2SetPowerState(client *gophercloud.ServiceClient, d *schema.ResourceData, current, target string, timeout time.Duration) error {
+	current = strings.ToLower(current)
+	target = strings.ToLower(target)
+
+	if current == target {
+		return nil
+	}
+
+	// Stopped instances can be shelved or rescued, and shelved instances
+	// can be offloaded, without starting them first.
+	direct := current == "shelved" && target == "shelved_offloaded"
+	if current == "shutoff" {
+		switch target {
+		case "shelved", "shelved_offloaded", "rescue":
+			direct = true
+		}
+	}
+
+	if current != "active" && !direct {
+		if err := computeInstanceV2LeavePowerState(client, d.Id(), current, timeout); err != nil {
+			return err
+		}
+		current = "active"
+	}
+
+	return computeInstanceV2EnterPowerState(client, d, current, target, timeout)
+}
+
+// computeInstanceV2LeavePowerState brings an instance back to active from
+// the given power_state.
+func computeInstanceV2LeavePowerState(client *gophercloud.ServiceClient, id, current string, timeout time.Duration) error {
+	var err error
+	switch current {
+	case "active":
+		return nil
+	case "shutoff":
+		err = startstop.Start(client, id).ExtractErr()
+	case "shelved", "shelved_offloaded":
+		err = shelveunshelve.Unshelve(client, id, shelveunshelve.UnshelveOpts{}).ExtractErr()
+	case "paused":
+		err = pauseunpause.Unpause(client, id).ExtractErr()
+	case "suspended":
+		err = suspendresume.Resume(client, id).ExtractErr()
+	case "rescue":
+		err = rescueunrescue.Unrescue(client, id).ExtractErr()
+	default:
+		return fmt.Errorf("Unable to change power_state of instance (%s) from %s", id, current)
+	}
+
+	if err != nil {
+		return fmt.Errorf("Error changing power_state of instance (%s) from %s to active: %s", id, current, err)
+	}
+
+	log.Printf("[DEBUG] Waiting for instance (%s) to become active", id)
+	_, err = computeInstanceV2WaitForPowerState(client, id, computeInstanceV2PowerStateStatuses(current), []string{"ACTIVE"}, timeout)
+
+	return err
+}
+
+// computeInstanceV2EnterPowerState moves an instance from the given
+// power_state to the target one with a single action.
+func computeInstanceV2EnterPowerState(client *gophercloud.ServiceClient, d *schema.ResourceData, current, target string, timeout time.Duration) error {
+	id := d.Id()
+	pending := computeInstanceV2PowerStateStatuses(current)
+
+	var err error
+	switch target {
+	case "active":
+		return nil
+	case "shutoff":
+		err = startstop.Stop(client, id).ExtractErr()
+	case "shelved":
+		err = shelveunshelve.Shelve(client, id).ExtractErr()
+	case "shelved_offloaded":
+		if current != "shelved" {
+			err = shelveunshelve.Shelve(client, id).ExtractErr()
+			if err != nil {
+				break
+			}
+
+			log.Printf("[DEBUG] Waiting for instance (%s) to become shelved", id)
+			server, err := computeInstanceV2WaitForPowerState(client, id, pending, computeInstanceV2PowerStateStatuses("shelved"), timeout)
+			if err != nil {
+				return err
+			}
+
+			if server.Status == "SHELVED_OFFLOADED" {
+				return nil
+			}
+
+			pending = computeInstanceV2PowerStateStatuses("shelved")
+		}
+
+		err = shelveunshelve.ShelveOffload(client, id).ExtractErr()
+	case "paused":
+		err = pauseunpause.Pause(client, id).ExtractErr()
+	case "suspended":
+		err = suspendresume.Suspend(client, id).ExtractErr()
+	case "rescue":
+		rescueOpts := rescueunrescue.RescueOpts{
+			AdminPass:      d.Get("admin_pass").(string),
+			RescueImageRef: d.Get("rescue_image_id").(string),
+		}
+		log.Printf("[DEBUG] Rescue configuration: %#v", rescueOpts)
+		_, err = rescueunrescue.Rescue(client, id, rescueOpts).Extract()
+	default:
+		return fmt.Errorf("Unable to change power_state of instance (%s) to %s", id, target)
+	}
+
+	if err != nil {
+		return fmt.Errorf("Error changing power_state of instance (%s) from %s to %s: %s", id, current, target, err)
+	}
+
+	log.Printf("[DEBUG] Waiting for instance (%s) to become %s", id, target)
+	_, err = computeInstanceV2WaitForPowerState(client, id, pending, computeInstanceV2PowerStateStatuses(target), timeout)
+
+	return err
+}
+
+func computeInstanceV2WaitForPowerState(client *gophercloud.ServiceClient, id string, pending, target []string, timeout time.Duration) (*servers.Server, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:    pending,
+		Target:     target,
+		Refresh:    ServerV2StateRefreshFunc(client, id),
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	server, err := stateConf.WaitForState()
+	if err != nil {
+		return nil, fmt.Errorf("Error waiting for instance (%s) to become %s: %s", id, strings.ToLower(strings.Join(target, " or ")), err)
+	}
+
+	return server.(*servers.Server), nil
+}
+
+// computeInstanceV2ImportMicroversion is the first compute API microversion
+// that returns the root device name of a server and the
+// delete_on_termination flag of its attached volumes.
+const computeInstanceV2ImportMicroversion = "2.3"
+
+// computeInstanceV2ImportServer is a custom struct for the server attributes
+// that are needed to rebuild the boot block_device of an imported instance.
+type computeInstanceV2ImportServer struct {
+	RootDeviceName  string                                  `json:"OS-EXT-SRV-ATTR:root_device_name"`
+	VolumesAttached []computeInstanceV2ImportVolumeAttached `json:"os-extended-volumes:volumes_attached"`
+}
+
+// computeInstanceV2ImportVolumeAttached is a volume attached to a server.
+type computeInstanceV2ImportVolumeAttached struct {
+	ID                  string `json:"id"`
+	DeleteOnTermination bool   `json:"delete_on_termination"`
+}
+
+// computeInstanceV2ImportVolume is a volume including the metadata of the
+// image it was created from.
+type computeInstanceV2ImportVolume struct {
+	volumes.Volume
+	VolumeImageMetadata map[string]string `json:"volume_image_metadata"`
+}
+
+// UnmarshalJSON is needed because volumes.Volume has its own UnmarshalJSON,
+// which would otherwise be promoted and skip volume_image_metadata.
+func (r *computeInstanceV2ImportVolume) UnmarshalJSON(b []byte) error {
+	if err := json.Unmarshal(b, &r.Volume); err != nil {
+		return err
+	}
+
+	var s struct {
+		VolumeImageMetadata map[string]string `json:"volume_image_metadata"`
+	}
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	r.VolumeImageMetadata = s.VolumeImageMetadata
+
+	return nil
+}
+
+// computeInstanceV2IsRootVolume reports whether the volume is attached to
+// the instance as its root device.
+func computeInstanceV2IsRootVolume(volume *volumes.Volume, instanceID, rootDeviceName string) bool {
+	for _, attachment := range volume.Attachments {
+		if attachment.ServerID == instanceID && attachment.Device == rootDeviceName {
+			return true
+		}
+	}
+
+	return false
+}
+
+// computeInstanceV2BootBlockDevice returns the block_device entry for a
+// boot volume. Volumes that Nova created from an image are described with an
+// image source so they match the usual boot-from-volume configuration.
+func computeInstanceV2BootBlockDevice(volume *computeInstanceV2ImportVolume, deleteOnTermination bool) map[string]interface{} {
+	blockDevice := map[string]interface{}{
+		"source_type":           "volume",
+		"uuid":                  volume.ID,
+		"destination_type":      "volume",
+		"boot_index":            0,
+		"delete_on_termination": deleteOnTermination,
+	}
+
+	if imageID := volume.VolumeImageMetadata["image_id"]; imageID != "" {
+		blockDevice["source_type"] = "image"
+		blockDevice["uuid"] = imageID
+		blockDevice["volume_size"] = volume.Size
+	}
+
+	return blockDevice
+}
+
+// computeInstanceV2ImportServerGroup is a server group including the project
+// that owns it.
+type computeInstanceV2ImportServerGroup struct {
+	ID        string   `json:"id"`
+	ProjectID string   `json:"project_id"`
+	Members   []string `json:"members"`
+}
+
+// computeInstanceV2SchedulerHintsGroup returns the ID of the only server
+// group of the project that has the instance as a member. An empty string is
+// returned when there is no such group or the membership is ambiguous.
+func computeInstanceV2SchedulerHintsGroup(serverGroups []computeInstanceV2ImportServerGroup, projectID, instanceID string) string {
+	var groupIDs []string
+	for _, sg := range serverGroups {
+		if sg.ProjectID != "" && projectID != "" && sg.ProjectID != projectID {
+			continue
+		}
+
+		for _, member := range sg.Members {
+			if member == instanceID {
+				groupIDs = append(groupIDs, sg.ID)
+				break
+			}
+		}
+	}
+
+	if len(groupIDs) != 1 {
+		return ""
+	}
+
+	return groupIDs[0]
+}
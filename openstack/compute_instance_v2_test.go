@@ -0,0 +1,190 @@
+package openstack
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/samuelbernardolip/gophercloud/openstack/blockstorage/v3/volumes"
+	"github.com/samuelbernardolip/gophercloud/openstack/compute/v2/servers"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestComputeInstanceV2RebuildOpts(t *testing.T) {
+	keyName := "key_1"
+	userData := "#cloud-config"
+
+	rebuildOpts := ComputeInstanceV2RebuildOpts{
+		RebuildOpts: servers.RebuildOpts{
+			ImageID: "image_1",
+			Metadata: map[string]string{
+				"foo": "bar",
+			},
+		},
+		KeyName:  &keyName,
+		UserData: &userData,
+	}
+
+	expected := map[string]interface{}{
+		"rebuild": map[string]interface{}{
+			"imageRef": "image_1",
+			"metadata": map[string]interface{}{
+				"foo": "bar",
+			},
+			"key_name":  "key_1",
+			"user_data": "I2Nsb3VkLWNvbmZpZw==",
+		},
+	}
+
+	actual, err := rebuildOpts.ToServerRebuildMap()
+
+	assert.NoError(t, err)
+	assert.Equal(t, expected, actual)
+}
+
+func TestComputeInstanceV2RebuildOptsUnset(t *testing.T) {
+	empty := ""
+
+	rebuildOpts := ComputeInstanceV2RebuildOpts{
+		RebuildOpts: servers.RebuildOpts{
+			ImageID: "image_1",
+		},
+		KeyName:  &empty,
+		UserData: &empty,
+	}
+
+	expected := map[string]interface{}{
+		"rebuild": map[string]interface{}{
+			"imageRef":  "image_1",
+			"key_name":  nil,
+			"user_data": nil,
+		},
+	}
+
+	actual, err := rebuildOpts.ToServerRebuildMap()
+
+	assert.NoError(t, err)
+	assert.Equal(t, expected, actual)
+}
+
+func TestComputeInstanceV2RebuildOptsUnchanged(t *testing.T) {
+	rebuildOpts := ComputeInstanceV2RebuildOpts{
+		RebuildOpts: servers.RebuildOpts{
+			ImageID: "image_1",
+		},
+	}
+
+	expected := map[string]interface{}{
+		"rebuild": map[string]interface{}{
+			"imageRef": "image_1",
+		},
+	}
+
+	actual, err := rebuildOpts.ToServerRebuildMap()
+
+	assert.NoError(t, err)
+	assert.Equal(t, expected, actual)
+}
+
+func TestExpandComputeInstanceV2RebuildOpts(t *testing.T) {
+	r := resourceComputeInstanceV2()
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"name":     "instance_1",
+		"key_pair": "key_1",
+	})
+
+	rebuildOpts, microversion := expandComputeInstanceV2RebuildOpts(d, "image_1")
+	assert.Equal(t, "image_1", rebuildOpts.ImageID)
+	if assert.NotNil(t, rebuildOpts.KeyName) {
+		assert.Equal(t, "key_1", *rebuildOpts.KeyName)
+	}
+	assert.Nil(t, rebuildOpts.UserData)
+	assert.Equal(t, computeInstanceV2RebuildMicroversion, microversion)
+
+	d = schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"name": "instance_1",
+	})
+
+	rebuildOpts, microversion = expandComputeInstanceV2RebuildOpts(d, "image_1")
+	assert.Nil(t, rebuildOpts.KeyName)
+	assert.Nil(t, rebuildOpts.UserData)
+	assert.Equal(t, "", microversion)
+}
+
+func TestComputeInstanceV2PowerStateStatuses(t *testing.T) {
+	assert.Equal(t, []string{"SHELVED", "SHELVED_OFFLOADED"}, computeInstanceV2PowerStateStatuses("shelved"))
+	assert.Equal(t, []string{"SHELVED_OFFLOADED"}, computeInstanceV2PowerStateStatuses("shelved_offloaded"))
+	assert.Equal(t, []string{"RESCUE"}, computeInstanceV2PowerStateStatuses("rescue"))
+	assert.Equal(t, []string{"ACTIVE"}, computeInstanceV2PowerStateStatuses("active"))
+}
+
+func TestSuppressPowerStateDiffs(t *testing.T) {
+	assert.True(t, suppressPowerStateDiffs("power_state", "error", "active", nil))
+	assert.True(t, suppressPowerStateDiffs("power_state", "migrating", "active", nil))
+	assert.True(t, suppressPowerStateDiffs("power_state", "shelved_offloaded", "shelved", nil))
+	assert.False(t, suppressPowerStateDiffs("power_state", "shelved", "shelved_offloaded", nil))
+	assert.False(t, suppressPowerStateDiffs("power_state", "paused", "active", nil))
+}
+
+func TestComputeInstanceV2ImportVolumeUnmarshal(t *testing.T) {
+	var volume computeInstanceV2ImportVolume
+	err := json.Unmarshal([]byte(`{
+		"id": "volume_1",
+		"size": 10,
+		"bootable": "true",
+		"created_at": "2018-01-01T00:00:00.000000",
+		"volume_image_metadata": {"image_id": "image_1"}
+	}`), &volume)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "volume_1", volume.ID)
+	assert.Equal(t, 10, volume.Size)
+	assert.Equal(t, 2018, volume.CreatedAt.Year())
+	assert.Equal(t, "image_1", volume.VolumeImageMetadata["image_id"])
+}
+
+func TestComputeInstanceV2IsRootVolume(t *testing.T) {
+	volume := volumes.Volume{
+		Attachments: []volumes.Attachment{
+			{ServerID: "server_2", Device: "/dev/vda"},
+			{ServerID: "server_1", Device: "/dev/vdb"},
+		},
+	}
+
+	assert.True(t, computeInstanceV2IsRootVolume(&volume, "server_2", "/dev/vda"))
+	assert.False(t, computeInstanceV2IsRootVolume(&volume, "server_1", "/dev/vda"))
+}
+
+func TestComputeInstanceV2BootBlockDevice(t *testing.T) {
+	volume := computeInstanceV2ImportVolume{
+		Volume: volumes.Volume{ID: "volume_1", Size: 10},
+	}
+
+	expected := map[string]interface{}{
+		"source_type":           "volume",
+		"uuid":                  "volume_1",
+		"destination_type":      "volume",
+		"boot_index":            0,
+		"delete_on_termination": true,
+	}
+	assert.Equal(t, expected, computeInstanceV2BootBlockDevice(&volume, true))
+
+	volume.VolumeImageMetadata = map[string]string{"image_id": "image_1"}
+	expected["source_type"] = "image"
+	expected["uuid"] = "image_1"
+	expected["volume_size"] = 10
+	assert.Equal(t, expected, computeInstanceV2BootBlockDevice(&volume, true))
+}
+
+func TestComputeInstanceV2SchedulerHintsGroup(t *testing.T) {
+	serverGroups := []computeInstanceV2ImportServerGroup{
+		{ID: "group_1", ProjectID: "project_1", Members: []string{"server_1"}},
+		{ID: "group_2", ProjectID: "project_2", Members: []string{"server_1", "server_2"}},
+		{ID: "group_3", ProjectID: "project_2", Members: []string{"server_2"}},
+	}
+
+	assert.Equal(t, "group_1", computeInstanceV2SchedulerHintsGroup(serverGroups, "project_1", "server_1"))
+	assert.Equal(t, "", computeInstanceV2SchedulerHintsGroup(serverGroups, "project_2", "server_2"))
+	assert.Equal(t, "", computeInstanceV2SchedulerHintsGroup(serverGroups, "", "server_1"))
+	assert.Equal(t, "", computeInstanceV2SchedulerHintsGroup(serverGroups, "project_1", "server_3"))
+}
@@ -7,6 +7,7 @@ import (
 	"log"
 	"net/http"
 	"os"
+	"time"
 
 	"github.com/hashicorp/terraform/helper/pathorcontents"
 	"github.com/hashicorp/terraform/terraform"
@@ -17,32 +18,40 @@ import (
 )
 
 type Config struct {
-	CACertFile        string
-	ClientCertFile    string
-	ClientKeyFile     string
-	Cloud             string
-	DefaultDomain     string
-	DomainID          string
-	DomainName        string
-	EndpointType      string
-	IdentityEndpoint  string
-	Insecure          *bool
-	Password          string
-	ProjectDomainName string
-	ProjectDomainID   string
-	Region            string
-	Swauth            bool
-	TenantID          string
-	TenantName        string
-	Token             string
-	UserDomainName    string
-	UserDomainID      string
-	Username          string
-	UserID            string
-	useOctavia        bool
-	oidcToken         string
-	oidcProtocol      string
-	oidcIDP           string
+	CACertFile                  string
+	ClientCertFile              string
+	ClientKeyFile               string
+	Cloud                       string
+	DefaultDomain               string
+	DomainID                    string
+	DomainName                  string
+	EndpointType                string
+	IdentityEndpoint            string
+	Insecure                    *bool
+	Password                    string
+	ProjectDomainName           string
+	ProjectDomainID             string
+	Region                      string
+	Swauth                      bool
+	TenantID                    string
+	TenantName                  string
+	Token                       string
+	UserDomainName              string
+	UserDomainID                string
+	Username                    string
+	UserID                      string
+	useOctavia                  bool
+	oidcToken                   string
+	oidcProtocol                string
+	oidcIDP                     string
+	applicationCredentialID     string
+	applicationCredentialName   string
+	applicationCredentialSecret string
+	endpointOverrides           map[string]interface{}
+	maxRetries                  int
+	minRetryBackoff             int
+	maxRetryBackoff             int
+	tokenCachePath              string
 
 	OsClient *gophercloud.ProviderClient
 }
@@ -146,6 +155,27 @@ func (c *Config) LoadAndValidate() error {
 		return err
 	}
 
+	// Application credentials are already scoped to a project, so any
+	// password, token or scope picked up from the configuration or the
+	// environment has to be dropped for Keystone to accept the request.
+	if c.applicationCredentialID != "" || c.applicationCredentialName != "" {
+		ao.ApplicationCredentialID = c.applicationCredentialID
+		ao.ApplicationCredentialName = c.applicationCredentialName
+		ao.ApplicationCredentialSecret = c.applicationCredentialSecret
+		ao.Password = ""
+		ao.TokenID = ""
+		ao.TenantID = ""
+		ao.TenantName = ""
+		ao.Scope = new(gophercloud.AuthScope)
+
+		if c.applicationCredentialID != "" {
+			ao.Username = ""
+			ao.UserID = ""
+			ao.DomainID = ""
+			ao.DomainName = ""
+		}
+	}
+
 	client, err := openstack.NewClient(ao.IdentityEndpoint)
 	if err != nil {
 		return err
@@ -200,14 +230,21 @@ func (c *Config) LoadAndValidate() error {
 	transport := &http.Transport{Proxy: http.ProxyFromEnvironment, TLSClientConfig: config}
 	client.HTTPClient = http.Client{
 		Transport: &LogRoundTripper{
-			Rt:      transport,
-			OsDebug: osDebug,
+			Rt:              transport,
+			OsDebug:         osDebug,
+			MaxRetries:      c.maxRetries,
+			MinRetryBackoff: time.Duration(c.minRetryBackoff) * time.Second,
+			MaxRetryBackoff: time.Duration(c.maxRetryBackoff) * time.Second,
 		},
 	}
 
 	// If using Swift Authentication, there's no need to validate authentication normally.
 	if !c.Swauth {
-		err = openstack.Authenticate(client, *ao)
+		if c.tokenCachePath != "" {
+			err = c.authenticateWithTokenCache(client, ao)
+		} else {
+			err = openstack.Authenticate(client, *ao)
+		}
 		if err != nil {
 			return err
 		}
@@ -229,60 +266,69 @@ func (c *Config) determineRegion(region string) string {
 	return region
 }
 
-func (c *Config) blockStorageV1Client(region string) (*gophercloud.ServiceClient, error) {
-	return openstack.NewBlockStorageV1(c.OsClient, gophercloud.EndpointOpts{
+type commonServiceClientInitFunc func(*gophercloud.ProviderClient, gophercloud.EndpointOpts) (*gophercloud.ServiceClient, error)
+
+// commonServiceClientInit creates a service client of the given service type.
+// If an endpoint override was specified for the service type, the catalog is
+// bypassed and the override is used as the base URL of the client.
+func (c *Config) commonServiceClientInit(newClient commonServiceClientInitFunc, region, service string) (*gophercloud.ServiceClient, error) {
+	if endpoint := c.determineEndpointOverride(service); endpoint != "" {
+		log.Printf("[DEBUG] OpenStack Endpoint for %s overridden: %s", service, endpoint)
+		return &gophercloud.ServiceClient{
+			ProviderClient: c.OsClient,
+			Endpoint:       endpoint,
+			Type:           service,
+		}, nil
+	}
+
+	return newClient(c.OsClient, gophercloud.EndpointOpts{
 		Region:       c.determineRegion(region),
 		Availability: c.getEndpointType(),
 	})
 }
 
+// determineEndpointOverride returns the normalized endpoint override of
+// the given service type, or an empty string if there is none.
+func (c *Config) determineEndpointOverride(service string) string {
+	if v, ok := c.endpointOverrides[service]; ok {
+		if endpoint, ok := v.(string); ok && endpoint != "" {
+			return gophercloud.NormalizeURL(endpoint)
+		}
+	}
+
+	return ""
+}
+
+func (c *Config) blockStorageV1Client(region string) (*gophercloud.ServiceClient, error) {
+	return c.commonServiceClientInit(openstack.NewBlockStorageV1, region, "volume")
+}
+
 func (c *Config) blockStorageV2Client(region string) (*gophercloud.ServiceClient, error) {
-	return openstack.NewBlockStorageV2(c.OsClient, gophercloud.EndpointOpts{
-		Region:       c.determineRegion(region),
-		Availability: c.getEndpointType(),
-	})
+	return c.commonServiceClientInit(openstack.NewBlockStorageV2, region, "volumev2")
 }
 
 func (c *Config) blockStorageV3Client(region string) (*gophercloud.ServiceClient, error) {
-	return openstack.NewBlockStorageV3(c.OsClient, gophercloud.EndpointOpts{
-		Region:       c.determineRegion(region),
-		Availability: c.getEndpointType(),
-	})
+	return c.commonServiceClientInit(openstack.NewBlockStorageV3, region, "volumev3")
 }
 
 func (c *Config) computeV2Client(region string) (*gophercloud.ServiceClient, error) {
-	return openstack.NewComputeV2(c.OsClient, gophercloud.EndpointOpts{
-		Region:       c.determineRegion(region),
-		Availability: c.getEndpointType(),
-	})
+	return c.commonServiceClientInit(openstack.NewComputeV2, region, "compute")
 }
 
 func (c *Config) dnsV2Client(region string) (*gophercloud.ServiceClient, error) {
-	return openstack.NewDNSV2(c.OsClient, gophercloud.EndpointOpts{
-		Region:       c.determineRegion(region),
-		Availability: c.getEndpointType(),
-	})
+	return c.commonServiceClientInit(openstack.NewDNSV2, region, "dns")
 }
 
 func (c *Config) identityV3Client(region string) (*gophercloud.ServiceClient, error) {
-	return openstack.NewIdentityV3(c.OsClient, gophercloud.EndpointOpts{
-		Region:       c.determineRegion(region),
-		Availability: c.getEndpointType(),
-	})
+	return c.commonServiceClientInit(openstack.NewIdentityV3, region, "identity")
 }
 
 func (c *Config) imageV2Client(region string) (*gophercloud.ServiceClient, error) {
-	return openstack.NewImageServiceV2(c.OsClient, gophercloud.EndpointOpts{
-		Region:       c.determineRegion(region),
-		Availability: c.getEndpointType(),
-	})
+	return c.commonServiceClientInit(openstack.NewImageServiceV2, region, "image")
 }
 
 func (c *Config) networkingV2Client(region string) (*gophercloud.ServiceClient, error) {
-	return openstack.NewNetworkV2(c.OsClient, gophercloud.EndpointOpts{
-		Region:       c.determineRegion(region),
-		Availability: c.getEndpointType(),
-	})
+	return c.commonServiceClientInit(openstack.NewNetworkV2, region, "network")
 }
 
 func (c *Config) objectStorageV1Client(region string) (*gophercloud.ServiceClient, error) {
@@ -294,31 +340,31 @@ func (c *Config) objectStorageV1Client(region string) (*gophercloud.ServiceClien
 		})
 	}
 
-	return openstack.NewObjectStorageV1(c.OsClient, gophercloud.EndpointOpts{
-		Region:       c.determineRegion(region),
-		Availability: c.getEndpointType(),
-	})
+	return c.commonServiceClientInit(openstack.NewObjectStorageV1, region, "object-store")
 }
 
 func (c *Config) loadBalancerV2Client(region string) (*gophercloud.ServiceClient, error) {
-	return openstack.NewLoadBalancerV2(c.OsClient, gophercloud.EndpointOpts{
-		Region:       c.determineRegion(region),
-		Availability: c.getEndpointType(),
-	})
+	return c.commonServiceClientInit(openstack.NewLoadBalancerV2, region, "load-balancer")
 }
 
 func (c *Config) databaseV1Client(region string) (*gophercloud.ServiceClient, error) {
-	return openstack.NewDBV1(c.OsClient, gophercloud.EndpointOpts{
-		Region:       c.determineRegion(region),
-		Availability: c.getEndpointType(),
-	})
+	return c.commonServiceClientInit(openstack.NewDBV1, region, "database")
 }
 
 func (c *Config) containerInfraV1Client(region string) (*gophercloud.ServiceClient, error) {
-	return openstack.NewContainerInfraV1(c.OsClient, gophercloud.EndpointOpts{
-		Region:       c.determineRegion(region),
-		Availability: c.getEndpointType(),
-	})
+	return c.commonServiceClientInit(openstack.NewContainerInfraV1, region, "container-infra")
+}
+
+func (c *Config) keyManagerV1Client(region string) (*gophercloud.ServiceClient, error) {
+	return c.commonServiceClientInit(openstack.NewKeyManagerV1, region, "key-manager")
+}
+
+func (c *Config) orchestrationV1Client(region string) (*gophercloud.ServiceClient, error) {
+	return c.commonServiceClientInit(openstack.NewOrchestrationV1, region, "orchestration")
+}
+
+func (c *Config) sharedfilesystemV2Client(region string) (*gophercloud.ServiceClient, error) {
+	return c.commonServiceClientInit(openstack.NewSharedFileSystemV2, region, "sharev2")
 }
 
 func (c *Config) getEndpointType() gophercloud.Availability {
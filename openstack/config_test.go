@@ -0,0 +1,38 @@
+package openstack
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/samuelbernardolip/gophercloud"
+	"github.com/samuelbernardolip/gophercloud/openstack"
+)
+
+func TestConfigDetermineEndpointOverride(t *testing.T) {
+	config := Config{
+		endpointOverrides: map[string]interface{}{
+			"compute": "http://compute.example.com:8774/v2.1",
+			"network": "",
+		},
+	}
+
+	assert.Equal(t, "http://compute.example.com:8774/v2.1/", config.determineEndpointOverride("compute"))
+	assert.Equal(t, "", config.determineEndpointOverride("network"))
+	assert.Equal(t, "", config.determineEndpointOverride("volumev3"))
+}
+
+func TestConfigCommonServiceClientInitEndpointOverride(t *testing.T) {
+	config := Config{
+		OsClient: &gophercloud.ProviderClient{},
+		endpointOverrides: map[string]interface{}{
+			"network": "http://network.example.com:9696/v2.0/",
+		},
+	}
+
+	client, err := config.commonServiceClientInit(openstack.NewNetworkV2, "RegionOne", "network")
+	assert.NoError(t, err)
+	assert.Equal(t, "http://network.example.com:9696/v2.0/", client.ResourceBaseURL())
+	assert.Equal(t, "network", client.Type)
+	assert.Equal(t, config.OsClient, client.ProviderClient)
+}
@@ -0,0 +1,110 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+
+	"github.com/samuelbernardolip/gophercloud/openstack/blockstorage/extensions/quotasets"
+)
+
+func dataSourceBlockStorageQuotasetV3() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceBlockStorageQuotasetV3Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"project_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"volumes": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"snapshots": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"gigabytes": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"per_volume_gigabytes": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"backups": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"backup_gigabytes": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"groups": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"in_use": quotaUsageSchema(),
+
+			"reserved": quotaUsageSchema(),
+		},
+	}
+}
+
+func dataSourceBlockStorageQuotasetV3Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	blockStorageClient, err := config.blockStorageV3Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack block storage client: %s", err)
+	}
+
+	projectID := d.Get("project_id").(string)
+
+	q, err := quotasets.GetUsage(blockStorageClient, projectID).Extract()
+	if err != nil {
+		return fmt.Errorf("Error retrieving openstack_blockstorage_quotaset_v3 for project %s: %s", projectID, err)
+	}
+
+	log.Printf("[DEBUG] Retrieved openstack_blockstorage_quotaset_v3 for project %s: %#v", projectID, q)
+
+	details := map[string]quotasets.QuotaUsage{
+		"volumes":              q.Volumes,
+		"snapshots":            q.Snapshots,
+		"gigabytes":            q.Gigabytes,
+		"per_volume_gigabytes": q.PerVolumeGigabytes,
+		"backups":              q.Backups,
+		"backup_gigabytes":     q.BackupGigabytes,
+		"groups":               q.Groups,
+	}
+
+	inUse := make(map[string]interface{}, len(details))
+	reserved := make(map[string]interface{}, len(details))
+	for k, v := range details {
+		d.Set(k, v.Limit)
+		inUse[k] = v.InUse
+		reserved[k] = v.Reserved
+	}
+
+	d.SetId(projectID)
+	d.Set("region", GetRegion(d, config))
+	d.Set("in_use", inUse)
+	d.Set("reserved", reserved)
+
+	return nil
+}
@@ -0,0 +1,53 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccBlockStorageV3QuotasetDataSource_basic(t *testing.T) {
+	projectName := fmt.Sprintf("tf_test_%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccBlockStorageV3QuotasetDataSource_basic(projectName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.openstack_blockstorage_quotaset_v3.source", "id",
+						"openstack_identity_project_v3.project_1", "id"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_blockstorage_quotaset_v3.source", "volumes", "5"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_blockstorage_quotaset_v3.source", "in_use.volumes", "0"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_blockstorage_quotaset_v3.source", "snapshots", "5"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_blockstorage_quotaset_v3.source", "in_use.snapshots", "0"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_blockstorage_quotaset_v3.source", "gigabytes", "100"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_blockstorage_quotaset_v3.source", "in_use.gigabytes", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccBlockStorageV3QuotasetDataSource_basic(projectName string) string {
+	return fmt.Sprintf(`
+%s
+
+data "openstack_blockstorage_quotaset_v3" "source" {
+  project_id = "${openstack_blockstorage_quotaset_v3.quotaset_1.project_id}"
+}
+`, testAccBlockStorageV3Quotaset_basic(projectName))
+}
@@ -0,0 +1,152 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+
+	"github.com/samuelbernardolip/gophercloud/openstack/compute/v2/extensions/quotasets"
+)
+
+func dataSourceComputeQuotasetV2() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceComputeQuotasetV2Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"project_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"fixed_ips": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"floating_ips": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"injected_file_content_bytes": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"injected_file_path_bytes": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"injected_files": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"key_pairs": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"metadata_items": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"ram": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"security_group_rules": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"security_groups": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"cores": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"instances": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"server_groups": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"server_group_members": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"in_use": quotaUsageSchema(),
+
+			"reserved": quotaUsageSchema(),
+		},
+	}
+}
+
+func dataSourceComputeQuotasetV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	computeClient, err := config.computeV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack compute client: %s", err)
+	}
+
+	projectID := d.Get("project_id").(string)
+
+	q, err := quotasets.GetDetail(computeClient, projectID).Extract()
+	if err != nil {
+		return fmt.Errorf("Error retrieving openstack_compute_quotaset_v2 for project %s: %s", projectID, err)
+	}
+
+	log.Printf("[DEBUG] Retrieved openstack_compute_quotaset_v2 for project %s: %#v", projectID, q)
+
+	details := map[string]quotasets.QuotaDetail{
+		"fixed_ips":                   q.FixedIPs,
+		"floating_ips":                q.FloatingIPs,
+		"injected_file_content_bytes": q.InjectedFileContentBytes,
+		"injected_file_path_bytes":    q.InjectedFilePathBytes,
+		"injected_files":              q.InjectedFiles,
+		"key_pairs":                   q.KeyPairs,
+		"metadata_items":              q.MetadataItems,
+		"ram":                         q.RAM,
+		"security_group_rules":        q.SecurityGroupRules,
+		"security_groups":             q.SecurityGroups,
+		"cores":                       q.Cores,
+		"instances":                   q.Instances,
+		"server_groups":               q.ServerGroups,
+		"server_group_members":        q.ServerGroupMembers,
+	}
+
+	inUse := make(map[string]interface{}, len(details))
+	reserved := make(map[string]interface{}, len(details))
+	for k, v := range details {
+		d.Set(k, v.Limit)
+		inUse[k] = v.InUse
+		reserved[k] = v.Reserved
+	}
+
+	d.SetId(projectID)
+	d.Set("region", GetRegion(d, config))
+	d.Set("in_use", inUse)
+	d.Set("reserved", reserved)
+
+	return nil
+}
@@ -0,0 +1,53 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccComputeV2QuotasetDataSource_basic(t *testing.T) {
+	projectName := fmt.Sprintf("tf_test_%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccComputeV2QuotasetDataSource_basic(projectName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.openstack_compute_quotaset_v2.source", "id",
+						"openstack_identity_project_v3.project_1", "id"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_compute_quotaset_v2.source", "cores", "4"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_compute_quotaset_v2.source", "in_use.cores", "0"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_compute_quotaset_v2.source", "instances", "5"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_compute_quotaset_v2.source", "in_use.instances", "0"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_compute_quotaset_v2.source", "ram", "8192"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_compute_quotaset_v2.source", "in_use.ram", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccComputeV2QuotasetDataSource_basic(projectName string) string {
+	return fmt.Sprintf(`
+%s
+
+data "openstack_compute_quotaset_v2" "source" {
+  project_id = "${openstack_compute_quotaset_v2.quotaset_1.project_id}"
+}
+`, testAccComputeV2Quotaset_basic(projectName))
+}
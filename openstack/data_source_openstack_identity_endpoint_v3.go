@@ -5,7 +5,6 @@ import (
 	"log"
 
 	"github.com/hashicorp/terraform/helper/schema"
-	"github.com/samuelbernardolip/gophercloud"
 	"github.com/samuelbernardolip/gophercloud/openstack/identity/v3/endpoints"
 	"github.com/samuelbernardolip/gophercloud/openstack/identity/v3/services"
 )
@@ -64,16 +63,8 @@ func dataSourceIdentityEndpointV3Read(d *schema.ResourceData, meta interface{})
 		return fmt.Errorf("Error creating OpenStack identity client: %s", err)
 	}
 
-	availability := gophercloud.AvailabilityPublic
-	switch d.Get("interface") {
-	case "internal":
-		availability = gophercloud.AvailabilityInternal
-	case "admin":
-		availability = gophercloud.AvailabilityAdmin
-	}
-
 	listOpts := endpoints.ListOpts{
-		Availability: availability,
+		Availability: identityEndpointV3Availability(d.Get("interface").(string)),
 		ServiceID:    d.Get("service_id").(string),
 	}
 
@@ -123,6 +123,16 @@ func dataSourceImagesImageV2() *schema.Resource {
 				Computed: true,
 			},
 
+			"os_hash_algo": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"os_hash_value": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
 			"size_bytes": {
 				Type:     schema.TypeInt,
 				Computed: true,
@@ -260,6 +270,8 @@ func dataSourceImagesImageV2Attributes(d *schema.ResourceData, image *images.Ima
 	d.Set("protected", image.Protected)
 	d.Set("visibility", image.Visibility)
 	d.Set("checksum", image.Checksum)
+	d.Set("os_hash_algo", imagesImageV2Property(image, "os_hash_algo"))
+	d.Set("os_hash_value", imagesImageV2Property(image, "os_hash_value"))
 	d.Set("size_bytes", image.SizeBytes)
 	d.Set("metadata", image.Metadata)
 	d.Set("created_at", image.CreatedAt)
@@ -0,0 +1,153 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/samuelbernardolip/gophercloud/openstack/keymanager/v1/containers"
+)
+
+func dataSourceKeyManagerContainerV1() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceKeyManagerContainerV1Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"type": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"secret_refs": &schema.Schema{
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"secret_ref": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"container_ref": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"creator_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"status": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"created_at": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"updated_at": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"consumers": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"url": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceKeyManagerContainerV1Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	kmClient, err := config.keyManagerV1Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack key manager client: %s", err)
+	}
+
+	listOpts := containers.ListOpts{
+		Name: d.Get("name").(string),
+	}
+
+	log.Printf("[DEBUG] openstack_keymanager_container_v1 list options: %#v", listOpts)
+
+	allPages, err := containers.List(kmClient, listOpts).AllPages()
+	if err != nil {
+		return fmt.Errorf("Unable to query openstack_keymanager_container_v1 containers: %s", err)
+	}
+
+	allContainers, err := containers.ExtractContainers(allPages)
+	if err != nil {
+		return fmt.Errorf("Unable to retrieve openstack_keymanager_container_v1 containers: %s", err)
+	}
+
+	if len(allContainers) < 1 {
+		return fmt.Errorf("Your query returned no results. " +
+			"Please change your search criteria and try again.")
+	}
+
+	if len(allContainers) > 1 {
+		return fmt.Errorf("Your query returned more than one result." +
+			" Please try a more specific search criteria")
+	}
+
+	container := allContainers[0]
+	uuid := keyManagerV1GetUUIDfromRef(container.ContainerRef)
+
+	log.Printf("[DEBUG] Retrieved openstack_keymanager_container_v1 %s: %#v", uuid, container)
+	d.SetId(uuid)
+
+	d.Set("name", container.Name)
+	d.Set("type", container.Type)
+	d.Set("container_ref", container.ContainerRef)
+	d.Set("creator_id", container.CreatorID)
+	d.Set("status", container.Status)
+	d.Set("created_at", container.Created.Format(time.RFC3339))
+	d.Set("updated_at", container.Updated.Format(time.RFC3339))
+
+	if err := d.Set("secret_refs", flattenKeyManagerContainerV1SecretRefs(container.SecretRefs)); err != nil {
+		return fmt.Errorf("Unable to set openstack_keymanager_container_v1 secret_refs: %s", err)
+	}
+
+	if err := d.Set("consumers", flattenKeyManagerContainerV1Consumers(container.Consumers)); err != nil {
+		return fmt.Errorf("Unable to set openstack_keymanager_container_v1 consumers: %s", err)
+	}
+
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
@@ -0,0 +1,76 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+var keyManagerContainerName = fmt.Sprintf("ACPTTEST%s", acctest.RandString(5))
+
+func TestAccOpenStackKeyManagerContainerV1DataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheckKeyManager(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccOpenStackKeyManagerContainerV1DataSource_container,
+			},
+			resource.TestStep{
+				Config: testAccOpenStackKeyManagerContainerV1DataSource_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeyManagerContainerV1DataSourceID("data.openstack_keymanager_container_v1.container_1"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_keymanager_container_v1.container_1", "name", keyManagerContainerName),
+					resource.TestCheckResourceAttr(
+						"data.openstack_keymanager_container_v1.container_1", "type", "generic"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_keymanager_container_v1.container_1", "secret_refs.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckKeyManagerContainerV1DataSourceID(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Can't find key manager container data source: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("Key manager container data source ID not set")
+		}
+
+		return nil
+	}
+}
+
+var testAccOpenStackKeyManagerContainerV1DataSource_container = fmt.Sprintf(`
+resource "openstack_keymanager_secret_v1" "secret_1" {
+  name = "secret_1"
+  payload = "foobar"
+  payload_content_type = "text/plain"
+  secret_type = "passphrase"
+}
+
+resource "openstack_keymanager_container_v1" "container_1" {
+  name = "%s"
+  type = "generic"
+
+  secret_refs {
+    name = "foo"
+    secret_ref = "${openstack_keymanager_secret_v1.secret_1.secret_ref}"
+  }
+}`, keyManagerContainerName)
+
+var testAccOpenStackKeyManagerContainerV1DataSource_basic = fmt.Sprintf(`
+%s
+data "openstack_keymanager_container_v1" "container_1" {
+	name = "${openstack_keymanager_container_v1.container_1.name}"
+}
+`, testAccOpenStackKeyManagerContainerV1DataSource_container)
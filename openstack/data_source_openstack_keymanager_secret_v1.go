@@ -0,0 +1,197 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/samuelbernardolip/gophercloud/openstack/keymanager/v1/secrets"
+)
+
+func dataSourceKeyManagerSecretV1() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceKeyManagerSecretV1Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"bit_length": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+
+			"algorithm": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"mode": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"secret_type": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"symmetric", "public", "private", "passphrase", "certificate", "opaque",
+				}, false),
+			},
+
+			"acl_only": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+
+			"secret_ref": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"creator_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"status": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"expiration": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"payload": &schema.Schema{
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"payload_content_type": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"content_types": &schema.Schema{
+				Type:     schema.TypeMap,
+				Computed: true,
+			},
+
+			"metadata": &schema.Schema{
+				Type:     schema.TypeMap,
+				Computed: true,
+			},
+
+			"created_at": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"updated_at": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceKeyManagerSecretV1Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	kmClient, err := config.keyManagerV1Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack key manager client: %s", err)
+	}
+
+	listOpts := secrets.ListOpts{
+		Name:       d.Get("name").(string),
+		Bits:       d.Get("bit_length").(int),
+		Alg:        d.Get("algorithm").(string),
+		Mode:       d.Get("mode").(string),
+		SecretType: keyManagerSecretV1SecretType(d.Get("secret_type").(string)),
+	}
+
+	if v, ok := d.GetOkExists("acl_only"); ok {
+		aclOnly := v.(bool)
+		listOpts.ACLOnly = &aclOnly
+	}
+
+	log.Printf("[DEBUG] openstack_keymanager_secret_v1 list options: %#v", listOpts)
+
+	allPages, err := secrets.List(kmClient, listOpts).AllPages()
+	if err != nil {
+		return fmt.Errorf("Unable to query openstack_keymanager_secret_v1 secrets: %s", err)
+	}
+
+	allSecrets, err := secrets.ExtractSecrets(allPages)
+	if err != nil {
+		return fmt.Errorf("Unable to retrieve openstack_keymanager_secret_v1 secrets: %s", err)
+	}
+
+	if len(allSecrets) < 1 {
+		return fmt.Errorf("Your query returned no results. " +
+			"Please change your search criteria and try again.")
+	}
+
+	if len(allSecrets) > 1 {
+		return fmt.Errorf("Your query returned more than one result." +
+			" Please try a more specific search criteria")
+	}
+
+	secret := allSecrets[0]
+	uuid := keyManagerV1GetUUIDfromRef(secret.SecretRef)
+
+	log.Printf("[DEBUG] Retrieved openstack_keymanager_secret_v1 %s: %#v", uuid, secret)
+	d.SetId(uuid)
+
+	d.Set("name", secret.Name)
+	d.Set("bit_length", secret.BitLength)
+	d.Set("algorithm", secret.Algorithm)
+	d.Set("mode", secret.Mode)
+	d.Set("secret_type", secret.SecretType)
+	d.Set("secret_ref", secret.SecretRef)
+	d.Set("creator_id", secret.CreatorID)
+	d.Set("status", secret.Status)
+	d.Set("content_types", secret.ContentTypes)
+	d.Set("created_at", secret.Created.Format(time.RFC3339))
+	d.Set("updated_at", secret.Updated.Format(time.RFC3339))
+
+	if !secret.Expiration.IsZero() {
+		d.Set("expiration", secret.Expiration.Format(time.RFC3339))
+	}
+
+	if contentType, ok := secret.ContentTypes["default"]; ok {
+		payload, err := keyManagerSecretV1GetPayload(kmClient, uuid, contentType)
+		if err != nil {
+			return fmt.Errorf("Error retrieving payload of openstack_keymanager_secret_v1 %s: %s", uuid, err)
+		}
+		d.Set("payload", payload)
+		d.Set("payload_content_type", contentType)
+	}
+
+	metadata, err := secrets.GetMetadata(kmClient, uuid).Extract()
+	if err != nil {
+		return fmt.Errorf("Error retrieving metadata of openstack_keymanager_secret_v1 %s: %s", uuid, err)
+	}
+	d.Set("metadata", metadata)
+
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
@@ -0,0 +1,73 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+var keyManagerSecretName = fmt.Sprintf("ACPTTEST%s", acctest.RandString(5))
+
+func TestAccOpenStackKeyManagerSecretV1DataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheckKeyManager(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccOpenStackKeyManagerSecretV1DataSource_secret,
+			},
+			resource.TestStep{
+				Config: testAccOpenStackKeyManagerSecretV1DataSource_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeyManagerSecretV1DataSourceID("data.openstack_keymanager_secret_v1.secret_1"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_keymanager_secret_v1.secret_1", "name", keyManagerSecretName),
+					resource.TestCheckResourceAttr(
+						"data.openstack_keymanager_secret_v1.secret_1", "secret_type", "passphrase"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_keymanager_secret_v1.secret_1", "payload", "foobar"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_keymanager_secret_v1.secret_1", "metadata.foo", "bar"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckKeyManagerSecretV1DataSourceID(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Can't find key manager secret data source: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("Key manager secret data source ID not set")
+		}
+
+		return nil
+	}
+}
+
+var testAccOpenStackKeyManagerSecretV1DataSource_secret = fmt.Sprintf(`
+resource "openstack_keymanager_secret_v1" "secret_1" {
+  name = "%s"
+  payload = "foobar"
+  payload_content_type = "text/plain"
+  secret_type = "passphrase"
+
+  metadata {
+    foo = "bar"
+  }
+}`, keyManagerSecretName)
+
+var testAccOpenStackKeyManagerSecretV1DataSource_basic = fmt.Sprintf(`
+%s
+data "openstack_keymanager_secret_v1" "secret_1" {
+	name = "${openstack_keymanager_secret_v1.secret_1.name}"
+	secret_type = "passphrase"
+}
+`, testAccOpenStackKeyManagerSecretV1DataSource_secret)
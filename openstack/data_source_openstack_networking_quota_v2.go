@@ -0,0 +1,122 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+
+	"github.com/samuelbernardolip/gophercloud/openstack/networking/v2/extensions/quotas"
+)
+
+func dataSourceNetworkingQuotaV2() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNetworkingQuotaV2Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"project_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"floatingip": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"network": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"port": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"rbac_policy": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"router": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"security_group": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"security_group_rule": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"subnet": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"subnetpool": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"in_use": quotaUsageSchema(),
+
+			"reserved": quotaUsageSchema(),
+		},
+	}
+}
+
+func dataSourceNetworkingQuotaV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	projectID := d.Get("project_id").(string)
+
+	q, err := quotas.GetDetail(networkingClient, projectID).Extract()
+	if err != nil {
+		return fmt.Errorf("Error retrieving openstack_networking_quota_v2 for project %s: %s", projectID, err)
+	}
+
+	log.Printf("[DEBUG] Retrieved openstack_networking_quota_v2 for project %s: %#v", projectID, q)
+
+	details := map[string]quotas.QuotaDetail{
+		"floatingip":          q.FloatingIP,
+		"network":             q.Network,
+		"port":                q.Port,
+		"rbac_policy":         q.RBACPolicy,
+		"router":              q.Router,
+		"security_group":      q.SecurityGroup,
+		"security_group_rule": q.SecurityGroupRule,
+		"subnet":              q.Subnet,
+		"subnetpool":          q.SubnetPool,
+	}
+
+	inUse := make(map[string]interface{}, len(details))
+	reserved := make(map[string]interface{}, len(details))
+	for k, v := range details {
+		d.Set(k, v.Limit)
+		inUse[k] = v.Used
+		reserved[k] = v.Reserved
+	}
+
+	d.SetId(projectID)
+	d.Set("region", GetRegion(d, config))
+	d.Set("in_use", inUse)
+	d.Set("reserved", reserved)
+
+	return nil
+}
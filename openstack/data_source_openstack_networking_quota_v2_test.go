@@ -0,0 +1,53 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccNetworkingV2QuotaDataSource_basic(t *testing.T) {
+	projectName := fmt.Sprintf("tf_test_%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccNetworkingV2QuotaDataSource_basic(projectName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.openstack_networking_quota_v2.source", "id",
+						"openstack_identity_project_v3.project_1", "id"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_networking_quota_v2.source", "network", "4"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_networking_quota_v2.source", "in_use.network", "0"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_networking_quota_v2.source", "port", "20"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_networking_quota_v2.source", "in_use.port", "0"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_networking_quota_v2.source", "router", "2"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_networking_quota_v2.source", "in_use.router", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNetworkingV2QuotaDataSource_basic(projectName string) string {
+	return fmt.Sprintf(`
+%s
+
+data "openstack_networking_quota_v2" "source" {
+  project_id = "${openstack_networking_quota_v2.quota_1.project_id}"
+}
+`, testAccNetworkingV2Quota_basic(projectName))
+}
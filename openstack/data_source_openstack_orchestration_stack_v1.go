@@ -0,0 +1,141 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/samuelbernardolip/gophercloud/openstack/orchestration/v1/stacks"
+)
+
+func dataSourceOrchestrationStackV1() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceOrchestrationStackV1Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"stack_id": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"name"},
+			},
+
+			"name": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"stack_id"},
+			},
+
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"parameters": &schema.Schema{
+				Type:     schema.TypeMap,
+				Computed: true,
+			},
+
+			"outputs": &schema.Schema{
+				Type:     schema.TypeMap,
+				Computed: true,
+			},
+
+			"timeout": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"disable_rollback": &schema.Schema{
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
+			"tags": &schema.Schema{
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+
+			"status": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"status_reason": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"parent_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"creation_time": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"updated_time": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceOrchestrationStackV1Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	orchestrationClient, err := config.orchestrationV1Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack orchestration client: %s", err)
+	}
+
+	identity := d.Get("stack_id").(string)
+	if identity == "" {
+		identity = d.Get("name").(string)
+	}
+
+	if identity == "" {
+		return fmt.Errorf("One of stack_id or name must be set for openstack_orchestration_stack_v1")
+	}
+
+	stack, err := stacks.Find(orchestrationClient, identity).Extract()
+	if err != nil {
+		return fmt.Errorf("Unable to retrieve openstack_orchestration_stack_v1 %s: %s", identity, err)
+	}
+
+	log.Printf("[DEBUG] Retrieved openstack_orchestration_stack_v1 %s: %#v", stack.ID, stack)
+	d.SetId(stack.ID)
+
+	d.Set("stack_id", stack.ID)
+	d.Set("name", stack.Name)
+	d.Set("description", stack.Description)
+	d.Set("parameters", orchestrationStackV1Parameters(stack.Parameters))
+	d.Set("outputs", flattenOrchestrationStackV1Outputs(stack.Outputs))
+	d.Set("timeout", stack.Timeout)
+	d.Set("disable_rollback", stack.DisableRollback)
+	d.Set("tags", stack.Tags)
+	d.Set("status", stack.Status)
+	d.Set("status_reason", stack.StatusReason)
+	d.Set("parent_id", stack.ParentID)
+	d.Set("creation_time", stack.CreationTime.Format(time.RFC3339))
+
+	if !stack.UpdatedTime.IsZero() {
+		d.Set("updated_time", stack.UpdatedTime.Format(time.RFC3339))
+	}
+
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
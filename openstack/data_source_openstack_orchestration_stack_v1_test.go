@@ -0,0 +1,75 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccOpenStackOrchestrationStackV1DataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheckOrchestration(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccOrchestrationV1Stack_basic,
+			},
+			resource.TestStep{
+				Config: testAccOpenStackOrchestrationStackV1DataSource_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOrchestrationStackV1DataSourceID("data.openstack_orchestration_stack_v1.stack_1"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_orchestration_stack_v1.stack_1", "name", "stack_1"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_orchestration_stack_v1.stack_1", "status", "CREATE_COMPLETE"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_orchestration_stack_v1.stack_1", "parameters.length", "8"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_orchestration_stack_v1.stack_1", "outputs.length", "8"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccOpenStackOrchestrationStackV1DataSource_name,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOrchestrationStackV1DataSourceID("data.openstack_orchestration_stack_v1.stack_1"),
+					resource.TestCheckResourceAttrPair(
+						"data.openstack_orchestration_stack_v1.stack_1", "id",
+						"openstack_orchestration_stack_v1.stack_1", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckOrchestrationStackV1DataSourceID(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Can't find orchestration stack data source: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("Orchestration stack data source ID not set")
+		}
+
+		return nil
+	}
+}
+
+var testAccOpenStackOrchestrationStackV1DataSource_basic = fmt.Sprintf(`
+%s
+
+data "openstack_orchestration_stack_v1" "stack_1" {
+  stack_id = "${openstack_orchestration_stack_v1.stack_1.id}"
+}
+`, testAccOrchestrationV1Stack_basic)
+
+var testAccOpenStackOrchestrationStackV1DataSource_name = fmt.Sprintf(`
+%s
+
+data "openstack_orchestration_stack_v1" "stack_1" {
+  name = "${openstack_orchestration_stack_v1.stack_1.name}"
+}
+`, testAccOrchestrationV1Stack_basic)
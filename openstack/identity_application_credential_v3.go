@@ -0,0 +1,68 @@
+package openstack
+
+import (
+	"github.com/samuelbernardolip/gophercloud"
+	"github.com/samuelbernardolip/gophercloud/openstack/identity/v3/applicationcredentials"
+	"github.com/samuelbernardolip/gophercloud/openstack/identity/v3/tokens"
+)
+
+// identityV3TokenUserID returns the ID of the user the provider is
+// authenticated as. Application credentials are always owned by that user.
+func identityV3TokenUserID(identityClient *gophercloud.ServiceClient, tokenID string) (string, error) {
+	user, err := tokens.Get(identityClient, tokenID).ExtractUser()
+	if err != nil {
+		return "", err
+	}
+
+	return user.ID, nil
+}
+
+func expandIdentityApplicationCredentialRolesV3(roles []interface{}) []applicationcredentials.Role {
+	var res []applicationcredentials.Role
+
+	for _, role := range roles {
+		res = append(res, applicationcredentials.Role{Name: role.(string)})
+	}
+
+	return res
+}
+
+func flattenIdentityApplicationCredentialRolesV3(roles []applicationcredentials.Role) []string {
+	var res []string
+
+	for _, role := range roles {
+		res = append(res, role.Name)
+	}
+
+	return res
+}
+
+func expandIdentityApplicationCredentialAccessRulesV3(rules []interface{}) []applicationcredentials.AccessRule {
+	var res []applicationcredentials.AccessRule
+
+	for _, v := range rules {
+		rule := v.(map[string]interface{})
+		res = append(res, applicationcredentials.AccessRule{
+			Path:    rule["path"].(string),
+			Method:  rule["method"].(string),
+			Service: rule["service"].(string),
+		})
+	}
+
+	return res
+}
+
+func flattenIdentityApplicationCredentialAccessRulesV3(rules []applicationcredentials.AccessRule) []map[string]interface{} {
+	var res []map[string]interface{}
+
+	for _, rule := range rules {
+		res = append(res, map[string]interface{}{
+			"id":      rule.ID,
+			"path":    rule.Path,
+			"method":  rule.Method,
+			"service": rule.Service,
+		})
+	}
+
+	return res
+}
@@ -0,0 +1,78 @@
+package openstack
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/samuelbernardolip/gophercloud/openstack/identity/v3/applicationcredentials"
+)
+
+func TestExpandIdentityApplicationCredentialRolesV3(t *testing.T) {
+	roles := []interface{}{"admin", "member"}
+
+	expected := []applicationcredentials.Role{
+		applicationcredentials.Role{Name: "admin"},
+		applicationcredentials.Role{Name: "member"},
+	}
+
+	actual := expandIdentityApplicationCredentialRolesV3(roles)
+	assert.Equal(t, expected, actual)
+}
+
+func TestFlattenIdentityApplicationCredentialRolesV3(t *testing.T) {
+	roles := []applicationcredentials.Role{
+		applicationcredentials.Role{ID: "1", Name: "admin"},
+		applicationcredentials.Role{ID: "2", Name: "member"},
+	}
+
+	expected := []string{"admin", "member"}
+
+	actual := flattenIdentityApplicationCredentialRolesV3(roles)
+	assert.Equal(t, expected, actual)
+}
+
+func TestExpandIdentityApplicationCredentialAccessRulesV3(t *testing.T) {
+	rules := []interface{}{
+		map[string]interface{}{
+			"id":      "",
+			"path":    "/v2.0/metrics",
+			"method":  "GET",
+			"service": "monitoring",
+		},
+	}
+
+	expected := []applicationcredentials.AccessRule{
+		applicationcredentials.AccessRule{
+			Path:    "/v2.0/metrics",
+			Method:  "GET",
+			Service: "monitoring",
+		},
+	}
+
+	actual := expandIdentityApplicationCredentialAccessRulesV3(rules)
+	assert.Equal(t, expected, actual)
+}
+
+func TestFlattenIdentityApplicationCredentialAccessRulesV3(t *testing.T) {
+	rules := []applicationcredentials.AccessRule{
+		applicationcredentials.AccessRule{
+			ID:      "rule",
+			Path:    "/v2.0/metrics",
+			Method:  "GET",
+			Service: "monitoring",
+		},
+	}
+
+	expected := []map[string]interface{}{
+		map[string]interface{}{
+			"id":      "rule",
+			"path":    "/v2.0/metrics",
+			"method":  "GET",
+			"service": "monitoring",
+		},
+	}
+
+	actual := flattenIdentityApplicationCredentialAccessRulesV3(rules)
+	assert.Equal(t, expected, actual)
+}
@@ -0,0 +1,38 @@
+package openstack
+
+import (
+	"github.com/samuelbernardolip/gophercloud"
+	"github.com/samuelbernardolip/gophercloud/openstack/identity/v3/endpoints"
+)
+
+// IdentityEndpointV3CreateOpts represents the attributes used when creating
+// a new endpoint. Unlike endpoints.CreateOpts, the name is optional, as it is
+// in the Identity API.
+type IdentityEndpointV3CreateOpts struct {
+	Availability gophercloud.Availability `json:"interface" required:"true"`
+	Name         string                   `json:"name,omitempty"`
+	Region       string                   `json:"region,omitempty"`
+	URL          string                   `json:"url" required:"true"`
+	ServiceID    string                   `json:"service_id" required:"true"`
+}
+
+// ToEndpointCreateMap casts a CreateOpts struct to a map.
+// It overrides endpoints.ToEndpointCreateMap to allow an empty name.
+func (opts IdentityEndpointV3CreateOpts) ToEndpointCreateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "endpoint")
+}
+
+// identityEndpointV3Get retrieves a single endpoint by ID. The endpoints
+// package only supports listing them.
+func identityEndpointV3Get(client *gophercloud.ServiceClient, id string) (*endpoints.Endpoint, error) {
+	var s struct {
+		Endpoint endpoints.Endpoint `json:"endpoint"`
+	}
+
+	_, err := client.Get(client.ServiceURL("endpoints", id), &s, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &s.Endpoint, nil
+}
@@ -0,0 +1,38 @@
+package openstack
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/samuelbernardolip/gophercloud"
+)
+
+func TestIdentityEndpointV3CreateOpts(t *testing.T) {
+	createOpts := IdentityEndpointV3CreateOpts{
+		Availability: gophercloud.AvailabilityPublic,
+		Region:       "RegionOne",
+		URL:          "http://localhost:8080",
+		ServiceID:    "service_1",
+	}
+
+	expected := map[string]interface{}{
+		"endpoint": map[string]interface{}{
+			"interface":  "public",
+			"region":     "RegionOne",
+			"url":        "http://localhost:8080",
+			"service_id": "service_1",
+		},
+	}
+
+	actual, err := createOpts.ToEndpointCreateMap()
+	assert.NoError(t, err)
+	assert.Equal(t, expected, actual)
+
+	createOpts.Name = "endpoint_1"
+	expected["endpoint"].(map[string]interface{})["name"] = "endpoint_1"
+
+	actual, err = createOpts.ToEndpointCreateMap()
+	assert.NoError(t, err)
+	assert.Equal(t, expected, actual)
+}
@@ -0,0 +1,39 @@
+package openstack
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/samuelbernardolip/gophercloud/openstack/identity/v3/tokens"
+)
+
+// parseImagesImageAccessID splits the ID of an image member into the image
+// ID and the member ID.
+func parseImagesImageAccessID(id string) (string, string, error) {
+	idParts := strings.Split(id, "/")
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		return "", "", fmt.Errorf("Unable to determine image access ID %s", id)
+	}
+
+	return idParts[0], idParts[1], nil
+}
+
+// imagesImageAccessV2CurrentProject returns the ID of the project the
+// provider is authenticated against.
+func imagesImageAccessV2CurrentProject(config *Config, region string) (string, error) {
+	identityClient, err := config.identityV3Client(region)
+	if err != nil {
+		return "", fmt.Errorf("Error creating OpenStack identity client: %s", err)
+	}
+
+	project, err := tokens.Get(identityClient, config.OsClient.TokenID).ExtractProject()
+	if err != nil {
+		return "", fmt.Errorf("Error retrieving the current project: %s", err)
+	}
+
+	if project == nil || project.ID == "" {
+		return "", fmt.Errorf("The provider is not scoped to a project, member_id must be set")
+	}
+
+	return project.ID, nil
+}
@@ -0,0 +1,19 @@
+package openstack
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseImagesImageAccessID(t *testing.T) {
+	imageID, memberID, err := parseImagesImageAccessID("1c0e4b5f-ab49-4ab6-b4ce-26c8bb6f8de3/a7ce7ed8ad584bf7a56d8b67db4e3bf7")
+	assert.NoError(t, err)
+	assert.Equal(t, "1c0e4b5f-ab49-4ab6-b4ce-26c8bb6f8de3", imageID)
+	assert.Equal(t, "a7ce7ed8ad584bf7a56d8b67db4e3bf7", memberID)
+
+	for _, id := range []string{"", "1c0e4b5f-ab49-4ab6-b4ce-26c8bb6f8de3", "1c0e4b5f/", "a/b/c"} {
+		_, _, err := parseImagesImageAccessID(id)
+		assert.Error(t, err, id)
+	}
+}
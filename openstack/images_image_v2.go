@@ -0,0 +1,216 @@
+package openstack
+
+import (
+	"crypto/md5"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"io"
+	"log"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/samuelbernardolip/gophercloud"
+	"github.com/samuelbernardolip/gophercloud/openstack/imageservice/v2/imageimport"
+	"github.com/samuelbernardolip/gophercloud/openstack/imageservice/v2/images"
+)
+
+// imagesImageV2ImportTaskType is the type of the tasks created by the
+// Image service for the imports of an image.
+const imagesImageV2ImportTaskType = "api_image_import"
+
+const (
+	// imagesImageV2StatusUploading denotes that the data of an image has
+	// been staged but not yet imported.
+	imagesImageV2StatusUploading images.ImageStatus = "uploading"
+
+	// imagesImageV2StatusImporting denotes that an import of an image has
+	// been requested but that the image is not yet ready for use.
+	imagesImageV2StatusImporting images.ImageStatus = "importing"
+)
+
+// imagesImageV2Property returns a string property set on an image by the
+// Image service.
+func imagesImageV2Property(img *images.Image, key string) string {
+	if v, ok := img.Properties[key].(string); ok {
+		return v
+	}
+
+	return ""
+}
+
+// imagesImageV2Stores returns the sorted stores that hold the data of an
+// image.
+func imagesImageV2Stores(img *images.Image) []string {
+	var stores []string
+	for _, store := range strings.Split(imagesImageV2Property(img, "stores"), ",") {
+		if store = strings.TrimSpace(store); store != "" {
+			stores = append(stores, store)
+		}
+	}
+	sort.Strings(stores)
+
+	return stores
+}
+
+// imagesImageV2LatestImportTask returns the most recent import task of an
+// image, or nil if there is none.
+func imagesImageV2LatestImportTask(tasks []imageimport.Task) *imageimport.Task {
+	var latest *imageimport.Task
+	for i, task := range tasks {
+		if task.Type != imagesImageV2ImportTaskType {
+			continue
+		}
+
+		if latest == nil || task.CreatedAt.After(latest.CreatedAt) {
+			latest = &tasks[i]
+		}
+	}
+
+	return latest
+}
+
+// imagesImageV2ImportState returns the state of an image which is being
+// imported. It is "importing" while an import task or store is still in
+// progress, the image status otherwise.
+func imagesImageV2ImportState(img *images.Image, tasks []imageimport.Task) (string, error) {
+	if task := imagesImageV2LatestImportTask(tasks); task != nil {
+		switch task.Status {
+		case "failure":
+			return "", fmt.Errorf("Error importing image %s: task %s failed: %s", img.ID, task.ID, task.Message)
+		case "pending", "processing":
+			return string(imagesImageV2StatusImporting), nil
+		}
+	}
+
+	if stores := imagesImageV2Property(img, "os_glance_importing_to_stores"); stores != "" {
+		return string(imagesImageV2StatusImporting), nil
+	}
+
+	if stores := imagesImageV2Property(img, "os_glance_failed_import"); stores != "" {
+		return "", fmt.Errorf("Error importing image %s to stores: %s", img.ID, stores)
+	}
+
+	return string(img.Status), nil
+}
+
+// imagesImageV2ImportRefreshFunc returns a resource.StateRefreshFunc that is
+// used to watch the import of an OpenStack image. The tasks of the image are
+// only available with newer versions of the Image service and are ignored
+// otherwise.
+func imagesImageV2ImportRefreshFunc(client *gophercloud.ServiceClient, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		img, err := images.Get(client, id).Extract()
+		if err != nil {
+			return nil, "", err
+		}
+
+		tasks, err := imageimport.ListTasks(client, id).Extract()
+		if err != nil {
+			if _, ok := err.(gophercloud.ErrDefault404); !ok {
+				return nil, "", err
+			}
+			log.Printf("[DEBUG] Unable to list the tasks of image %s: %s", id, err)
+		}
+
+		state, err := imagesImageV2ImportState(img, tasks)
+		if err != nil {
+			return nil, "", err
+		}
+		log.Printf("[DEBUG] OpenStack image %s import state is: %s", id, state)
+
+		return img, state, nil
+	}
+}
+
+// imagesImageV2Hashes computes the checksums of image data while it is read,
+// so that the data only has to be read once to be uploaded and verified.
+type imagesImageV2Hashes map[string]hash.Hash
+
+// newImagesImageV2Hashes returns the hashes used by the Image service: md5
+// for the checksum of an image, sha512 as its default os_hash_algo and
+// sha256 for the image_source_sha256 argument.
+func newImagesImageV2Hashes() imagesImageV2Hashes {
+	return imagesImageV2Hashes{
+		"md5":    md5.New(),
+		"sha256": sha256.New(),
+		"sha512": sha512.New(),
+	}
+}
+
+// Reader returns a reader that hashes everything read from r.
+func (h imagesImageV2Hashes) Reader(r io.Reader) io.Reader {
+	writers := make([]io.Writer, 0, len(h))
+	for _, v := range h {
+		writers = append(writers, v)
+	}
+
+	return io.TeeReader(r, io.MultiWriter(writers...))
+}
+
+// Sum returns the hex encoded checksum of the data read so far for an
+// algorithm, and whether that algorithm is computed.
+func (h imagesImageV2Hashes) Sum(algo string) (string, bool) {
+	v, ok := h[strings.ToLower(algo)]
+	if !ok {
+		return "", false
+	}
+
+	return hex.EncodeToString(v.Sum(nil)), true
+}
+
+// imagesImageV2VerifyHashes compares the checksum and the os_hash_value of
+// an image with the checksums of the data that was uploaded.
+func imagesImageV2VerifyHashes(img *images.Image, h imagesImageV2Hashes) error {
+	if sum, _ := h.Sum("md5"); img.Checksum != "" && img.Checksum != sum {
+		return fmt.Errorf("Error wrong checksum: got %q, expected %q", img.Checksum, sum)
+	}
+
+	// os_hash_algo is only provided by newer versions of the Image service.
+	hashAlgo := imagesImageV2Property(img, "os_hash_algo")
+	if hashAlgo == "" {
+		return nil
+	}
+
+	sum, ok := h.Sum(hashAlgo)
+	if !ok {
+		log.Printf("[WARN] Unable to verify the %s os_hash_value of image %s", hashAlgo, img.ID)
+		return nil
+	}
+
+	if hashValue := imagesImageV2Property(img, "os_hash_value"); hashValue != sum {
+		return fmt.Errorf("Error wrong %s os_hash_value: got %q, expected %q", hashAlgo, hashValue, sum)
+	}
+
+	return nil
+}
+
+// imagesImageV2VerifySHA256 compares the expected SHA-256 of an image source
+// with the SHA-256 of the data that was received.
+func imagesImageV2VerifySHA256(expected, actual string) error {
+	if !strings.EqualFold(expected, actual) {
+		return fmt.Errorf("Error wrong image_source_sha256: got %q, expected %q", actual, expected)
+	}
+
+	return nil
+}
+
+// imagesImageV2FileSHA256 returns the hex encoded SHA-256 of a file.
+func imagesImageV2FileSHA256(filename string) (string, error) {
+	f, err := os.Open(filename)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
@@ -0,0 +1,167 @@
+package openstack
+
+import (
+	"io/ioutil"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/samuelbernardolip/gophercloud/openstack/imageservice/v2/imageimport"
+	"github.com/samuelbernardolip/gophercloud/openstack/imageservice/v2/images"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestImagesImageV2Stores(t *testing.T) {
+	img := &images.Image{
+		Properties: map[string]interface{}{
+			"stores": "file, ceph",
+		},
+	}
+
+	assert.Equal(t, []string{"ceph", "file"}, imagesImageV2Stores(img))
+	assert.Empty(t, imagesImageV2Stores(&images.Image{}))
+}
+
+func TestImagesImageV2LatestImportTask(t *testing.T) {
+	now := time.Now()
+
+	tasks := []imageimport.Task{
+		{
+			ID:        "task_1",
+			Type:      imagesImageV2ImportTaskType,
+			CreatedAt: now.Add(-time.Hour),
+		},
+		{
+			ID:        "task_2",
+			Type:      imagesImageV2ImportTaskType,
+			CreatedAt: now,
+		},
+		{
+			ID:        "task_3",
+			Type:      "import",
+			CreatedAt: now.Add(time.Hour),
+		},
+	}
+
+	assert.Equal(t, "task_2", imagesImageV2LatestImportTask(tasks).ID)
+	assert.Nil(t, imagesImageV2LatestImportTask(nil))
+}
+
+func TestImagesImageV2ImportState(t *testing.T) {
+	img := &images.Image{
+		ID:         "image_1",
+		Status:     images.ImageStatusActive,
+		Properties: map[string]interface{}{},
+	}
+
+	state, err := imagesImageV2ImportState(img, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "active", state)
+
+	tasks := []imageimport.Task{
+		{
+			ID:     "task_1",
+			Type:   imagesImageV2ImportTaskType,
+			Status: "processing",
+		},
+	}
+
+	state, err = imagesImageV2ImportState(img, tasks)
+	assert.NoError(t, err)
+	assert.Equal(t, "importing", state)
+
+	tasks[0].Status = "failure"
+	tasks[0].Message = "image not found"
+
+	_, err = imagesImageV2ImportState(img, tasks)
+	assert.EqualError(t, err, "Error importing image image_1: task task_1 failed: image not found")
+
+	tasks[0].Status = "success"
+	img.Properties["os_glance_importing_to_stores"] = "ceph"
+
+	state, err = imagesImageV2ImportState(img, tasks)
+	assert.NoError(t, err)
+	assert.Equal(t, "importing", state)
+
+	img.Properties["os_glance_importing_to_stores"] = ""
+	img.Properties["os_glance_failed_import"] = "ceph"
+
+	_, err = imagesImageV2ImportState(img, tasks)
+	assert.EqualError(t, err, "Error importing image image_1 to stores: ceph")
+}
+
+func TestImagesImageV2Hashes(t *testing.T) {
+	hashes := newImagesImageV2Hashes()
+
+	data, err := ioutil.ReadAll(hashes.Reader(strings.NewReader("terraform")))
+	assert.NoError(t, err)
+	assert.Equal(t, "terraform", string(data))
+
+	sum, ok := hashes.Sum("md5")
+	assert.True(t, ok)
+	assert.Equal(t, "1b1ed905d54c18e3dd8828986c14be17", sum)
+
+	sum, ok = hashes.Sum("SHA256")
+	assert.True(t, ok)
+	assert.Equal(t, "94dc3ea57721d541aae09b7bf2368c1e20d4c89996ff6df4349d86048877c0e7", sum)
+
+	_, ok = hashes.Sum("sha3_512")
+	assert.False(t, ok)
+}
+
+func TestImagesImageV2VerifyHashes(t *testing.T) {
+	hashes := newImagesImageV2Hashes()
+	_, err := ioutil.ReadAll(hashes.Reader(strings.NewReader("terraform")))
+	assert.NoError(t, err)
+
+	img := &images.Image{
+		ID:       "image_1",
+		Checksum: "1b1ed905d54c18e3dd8828986c14be17",
+		Properties: map[string]interface{}{
+			"os_hash_algo":  "sha512",
+			"os_hash_value": "387a83cafdccab3e29b3cb96352640ada593e45e6ec7a7593f24f3499d59ee3cd10805d3d2296bd1c2bcd504dd08230bdaec8dab669f879a2f9b6ba609b3140b",
+		},
+	}
+	assert.NoError(t, imagesImageV2VerifyHashes(img, hashes))
+
+	img.Properties["os_hash_value"] = "0000"
+	assert.EqualError(t, imagesImageV2VerifyHashes(img, hashes),
+		`Error wrong sha512 os_hash_value: got "0000", expected "387a83cafdccab3e29b3cb96352640ada593e45e6ec7a7593f24f3499d59ee3cd10805d3d2296bd1c2bcd504dd08230bdaec8dab669f879a2f9b6ba609b3140b"`)
+
+	// Unknown algorithms and older Image services are not verified.
+	img.Properties["os_hash_algo"] = "sha3_512"
+	assert.NoError(t, imagesImageV2VerifyHashes(img, hashes))
+
+	delete(img.Properties, "os_hash_algo")
+	assert.NoError(t, imagesImageV2VerifyHashes(img, hashes))
+
+	img.Checksum = "0000"
+	assert.EqualError(t, imagesImageV2VerifyHashes(img, hashes),
+		`Error wrong checksum: got "0000", expected "1b1ed905d54c18e3dd8828986c14be17"`)
+}
+
+func TestImagesImageV2VerifySHA256(t *testing.T) {
+	sum := "94dc3ea57721d541aae09b7bf2368c1e20d4c89996ff6df4349d86048877c0e7"
+
+	assert.NoError(t, imagesImageV2VerifySHA256(sum, sum))
+	assert.NoError(t, imagesImageV2VerifySHA256(strings.ToUpper(sum), sum))
+	assert.Error(t, imagesImageV2VerifySHA256(sum, "0000"))
+}
+
+func TestImagesImageV2FileSHA256(t *testing.T) {
+	f, err := ioutil.TempFile("", "tf-openstack-image")
+	assert.NoError(t, err)
+	defer os.Remove(f.Name())
+
+	_, err = f.WriteString("terraform")
+	assert.NoError(t, err)
+	assert.NoError(t, f.Close())
+
+	sum, err := imagesImageV2FileSHA256(f.Name())
+	assert.NoError(t, err)
+	assert.Equal(t, "94dc3ea57721d541aae09b7bf2368c1e20d4c89996ff6df4349d86048877c0e7", sum)
+
+	_, err = imagesImageV2FileSHA256(f.Name() + ".missing")
+	assert.Error(t, err)
+}
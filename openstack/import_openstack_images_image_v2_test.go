@@ -28,6 +28,7 @@ func TestAccImagesImageV2_importBasic(t *testing.T) {
 					"image_cache_path",
 					"image_source_url",
 					"verify_checksum",
+					"import_method",
 				},
 			},
 		},
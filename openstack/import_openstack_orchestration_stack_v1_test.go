@@ -0,0 +1,29 @@
+package openstack
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccOrchestrationV1Stack_importBasic(t *testing.T) {
+	resourceName := "openstack_orchestration_stack_v1.stack_1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckOrchestration(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckOrchestrationV1StackDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccOrchestrationV1Stack_basic,
+			},
+
+			resource.TestStep{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"template"},
+			},
+		},
+	})
+}
@@ -0,0 +1,42 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccSFSV2ShareAccess_importBasic(t *testing.T) {
+	resourceName := "openstack_sharedfilesystem_share_access_v2.share_access_1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckSFS(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSFSV2ShareAccessDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccSFSV2ShareAccess_basic,
+			},
+
+			resource.TestStep{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccSFSV2ShareAccessImportID(resourceName),
+			},
+		},
+	})
+}
+
+func testAccSFSV2ShareAccessImportID(n string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", n)
+		}
+
+		return fmt.Sprintf("%s/%s", rs.Primary.Attributes["share_id"], rs.Primary.ID), nil
+	}
+}
@@ -0,0 +1,116 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/samuelbernardolip/gophercloud"
+	"github.com/samuelbernardolip/gophercloud/openstack/keymanager/v1/containers"
+)
+
+func keyManagerContainerV1Type(v string) containers.ContainerType {
+	var ctype containers.ContainerType
+	switch v {
+	case "generic":
+		ctype = containers.GenericContainer
+	case "rsa":
+		ctype = containers.RSAContainer
+	case "certificate":
+		ctype = containers.CertificateContainer
+	}
+
+	return ctype
+}
+
+func expandKeyManagerContainerV1SecretRefs(secretRefs *schema.Set) []containers.SecretRef {
+	l := make([]containers.SecretRef, 0, secretRefs.Len())
+
+	for _, v := range secretRefs.List() {
+		s := v.(map[string]interface{})
+		l = append(l, containers.SecretRef{
+			SecretRef: s["secret_ref"].(string),
+			Name:      s["name"].(string),
+		})
+	}
+
+	return l
+}
+
+func flattenKeyManagerContainerV1SecretRefs(sr []containers.SecretRef) []map[string]interface{} {
+	m := make([]map[string]interface{}, 0, len(sr))
+
+	for _, v := range sr {
+		m = append(m, map[string]interface{}{
+			"name":       v.Name,
+			"secret_ref": v.SecretRef,
+		})
+	}
+
+	return m
+}
+
+func flattenKeyManagerContainerV1Consumers(cr []containers.ConsumerRef) []map[string]interface{} {
+	m := make([]map[string]interface{}, 0, len(cr))
+
+	for _, v := range cr {
+		m = append(m, map[string]interface{}{
+			"name": v.Name,
+			"url":  v.URL,
+		})
+	}
+
+	return m
+}
+
+func keyManagerContainerV1WaitForContainerCreation(client *gophercloud.ServiceClient, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		container, err := containers.Get(client, id).Extract()
+		if err != nil {
+			if _, ok := err.(gophercloud.ErrDefault404); ok {
+				return "", "NOT_CREATED", nil
+			}
+
+			return "", "NOT_CREATED", err
+		}
+
+		if container.Status == "ERROR" {
+			return "", container.Status, fmt.Errorf("Error creating container")
+		}
+
+		log.Printf("[DEBUG] OpenStack Key Manager container (%s) current status: %s", id, container.Status)
+		return container, container.Status, nil
+	}
+}
+
+func keyManagerContainerV1WaitForContainerDeletion(client *gophercloud.ServiceClient, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		container, err := containers.Get(client, id).Extract()
+		if err != nil {
+			if _, ok := err.(gophercloud.ErrDefault404); ok {
+				return "", "DELETED", nil
+			}
+
+			return nil, "", err
+		}
+
+		log.Printf("[DEBUG] OpenStack Key Manager container (%s) current status: %s", id, container.Status)
+		return container, "ACTIVE", nil
+	}
+}
+
+// keyManagerContainerV1ForceNewSecretRefs forces a new container when the
+// secret_refs of a non-generic container change. Barbican only allows
+// secrets to be added to or removed from generic containers.
+func keyManagerContainerV1ForceNewSecretRefs(diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() == "" || !diff.HasChange("secret_refs") {
+		return nil
+	}
+
+	if diff.Get("type").(string) != "generic" {
+		return diff.ForceNew("secret_refs")
+	}
+
+	return nil
+}
@@ -0,0 +1,131 @@
+package openstack
+
+import (
+	"encoding/base64"
+	"fmt"
+	"log"
+	"mime"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/samuelbernardolip/gophercloud"
+	"github.com/samuelbernardolip/gophercloud/openstack/keymanager/v1/secrets"
+)
+
+// keyManagerV1GetUUIDfromRef returns the UUID at the end of a Barbican
+// secret or container reference URL.
+func keyManagerV1GetUUIDfromRef(ref string) string {
+	// refs have the form https://{barbican_host}/v1/{secrets|containers}/{uuid}
+	// so we are only interested in the last part
+	refSplit := strings.Split(ref, "/")
+	uuid := refSplit[len(refSplit)-1]
+	return uuid
+}
+
+func keyManagerSecretV1SecretType(v string) secrets.SecretType {
+	var stype secrets.SecretType
+	switch v {
+	case "symmetric":
+		stype = secrets.SymmetricSecret
+	case "public":
+		stype = secrets.PublicSecret
+	case "private":
+		stype = secrets.PrivateSecret
+	case "passphrase":
+		stype = secrets.PassphraseSecret
+	case "certificate":
+		stype = secrets.CertificateSecret
+	case "opaque":
+		stype = secrets.OpaqueSecret
+	}
+
+	return stype
+}
+
+func expandKeyManagerSecretV1Metadata(d *schema.ResourceData) secrets.MetadataOpts {
+	metadata := make(secrets.MetadataOpts)
+	for key, val := range d.Get("metadata").(map[string]interface{}) {
+		metadata[key] = val.(string)
+	}
+
+	return metadata
+}
+
+// keyManagerSecretV1GetPayload retrieves the payload of a secret. Binary
+// payloads are base64 encoded so they match the way they were provided.
+func keyManagerSecretV1GetPayload(client *gophercloud.ServiceClient, id string, contentType string) (string, error) {
+	opts := secrets.GetPayloadOpts{
+		PayloadContentType: contentType,
+	}
+
+	payload, err := secrets.GetPayload(client, id, opts).Extract()
+	if err != nil {
+		return "", err
+	}
+
+	if contentType == "application/octet-stream" {
+		return base64.StdEncoding.EncodeToString(payload), nil
+	}
+
+	return string(payload), nil
+}
+
+func keyManagerSecretV1WaitForSecretCreation(client *gophercloud.ServiceClient, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		secret, err := secrets.Get(client, id).Extract()
+		if err != nil {
+			if _, ok := err.(gophercloud.ErrDefault404); ok {
+				return "", "NOT_CREATED", nil
+			}
+
+			return "", "NOT_CREATED", err
+		}
+
+		if secret.Status == "ERROR" {
+			return "", secret.Status, fmt.Errorf("Error creating secret")
+		}
+
+		log.Printf("[DEBUG] OpenStack Key Manager secret (%s) current status: %s", id, secret.Status)
+		return secret, secret.Status, nil
+	}
+}
+
+func keyManagerSecretV1WaitForSecretDeletion(client *gophercloud.ServiceClient, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		secret, err := secrets.Get(client, id).Extract()
+		if err != nil {
+			if _, ok := err.(gophercloud.ErrDefault404); ok {
+				return "", "DELETED", nil
+			}
+
+			return nil, "", err
+		}
+
+		log.Printf("[DEBUG] OpenStack Key Manager secret (%s) current status: %s", id, secret.Status)
+		return secret, "ACTIVE", nil
+	}
+}
+
+// suppressKeyManagerSecretV1PayloadDiffs ignores surrounding whitespace in a
+// payload, since Barbican strips it from text/plain payloads.
+func suppressKeyManagerSecretV1PayloadDiffs(k, old, new string, d *schema.ResourceData) bool {
+	return strings.TrimSpace(old) == strings.TrimSpace(new)
+}
+
+// suppressKeyManagerSecretV1ContentTypeDiffs ignores parameters such as the
+// charset of a payload content type, since Barbican reports only the media
+// type.
+func suppressKeyManagerSecretV1ContentTypeDiffs(k, old, new string, d *schema.ResourceData) bool {
+	oldType, _, err := mime.ParseMediaType(old)
+	if err != nil {
+		return false
+	}
+
+	newType, _, err := mime.ParseMediaType(new)
+	if err != nil {
+		return false
+	}
+
+	return oldType == newType
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform/helper/schema"
 
 	"github.com/samuelbernardolip/gophercloud"
+	"github.com/samuelbernardolip/gophercloud/openstack/networking/v2/extensions/lbaas_v2/l7policies"
 	"github.com/samuelbernardolip/gophercloud/openstack/networking/v2/extensions/lbaas_v2/listeners"
 	"github.com/samuelbernardolip/gophercloud/openstack/networking/v2/extensions/lbaas_v2/loadbalancers"
 	"github.com/samuelbernardolip/gophercloud/openstack/networking/v2/extensions/lbaas_v2/monitors"
@@ -262,3 +263,98 @@ func waitForLBV2viaPool(lbClient *gophercloud.ServiceClient, id string, target s
 	// got a pool but no LB - this is wrong
 	return fmt.Errorf("No Load Balancer on pool %s", id)
 }
+
+// getLBV2LoadBalancerIDByListener returns the ID of the load balancer which
+// the listener belongs to.
+func getLBV2LoadBalancerIDByListener(lbClient *gophercloud.ServiceClient, listenerID string) (string, error) {
+	listener, err := listeners.Get(lbClient, listenerID).Extract()
+	if err != nil {
+		return "", err
+	}
+
+	if len(listener.Loadbalancers) == 0 {
+		return "", fmt.Errorf("No Load Balancer on listener %s", listenerID)
+	}
+
+	return listener.Loadbalancers[0].ID, nil
+}
+
+func waitForLBV2L7Policy(lbClient *gophercloud.ServiceClient, id string, target string, pending []string, timeout time.Duration) error {
+	log.Printf("[DEBUG] Waiting for l7policy %s to become %s.", id, target)
+
+	stateConf := &resource.StateChangeConf{
+		Target:     []string{target},
+		Pending:    pending,
+		Refresh:    resourceLBV2L7PolicyRefreshFunc(lbClient, id),
+		Timeout:    timeout,
+		Delay:      1 * time.Second,
+		MinTimeout: 1 * time.Second,
+	}
+
+	_, err := stateConf.WaitForState()
+	if err != nil {
+		if _, ok := err.(gophercloud.ErrDefault404); ok {
+			switch target {
+			case "DELETED":
+				return nil
+			default:
+				return fmt.Errorf("Error: l7policy %s not found: %s", id, err)
+			}
+		}
+		return fmt.Errorf("Error waiting for l7policy %s to become %s: %s", id, target, err)
+	}
+
+	return nil
+}
+
+func resourceLBV2L7PolicyRefreshFunc(lbClient *gophercloud.ServiceClient, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		l7policy, err := l7policies.Get(lbClient, id).Extract()
+		if err != nil {
+			return nil, "", err
+		}
+
+		// The Neutron l7policy resource has no provisioning status, so a successful Get is the best we can do
+		return l7policy, "ACTIVE", nil
+	}
+}
+
+func waitForLBV2L7Rule(lbClient *gophercloud.ServiceClient, l7policyID, l7ruleID string, target string, pending []string, timeout time.Duration) error {
+	log.Printf("[DEBUG] Waiting for l7rule %s to become %s.", l7ruleID, target)
+
+	stateConf := &resource.StateChangeConf{
+		Target:     []string{target},
+		Pending:    pending,
+		Refresh:    resourceLBV2L7RuleRefreshFunc(lbClient, l7policyID, l7ruleID),
+		Timeout:    timeout,
+		Delay:      1 * time.Second,
+		MinTimeout: 1 * time.Second,
+	}
+
+	_, err := stateConf.WaitForState()
+	if err != nil {
+		if _, ok := err.(gophercloud.ErrDefault404); ok {
+			switch target {
+			case "DELETED":
+				return nil
+			default:
+				return fmt.Errorf("Error: l7rule %s not found: %s", l7ruleID, err)
+			}
+		}
+		return fmt.Errorf("Error waiting for l7rule %s to become %s: %s", l7ruleID, target, err)
+	}
+
+	return nil
+}
+
+func resourceLBV2L7RuleRefreshFunc(lbClient *gophercloud.ServiceClient, l7policyID, l7ruleID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		l7rule, err := l7policies.GetRule(lbClient, l7policyID, l7ruleID).Extract()
+		if err != nil {
+			return nil, "", err
+		}
+
+		// The Neutron l7rule resource has no provisioning status, so a successful Get is the best we can do
+		return l7rule, "ACTIVE", nil
+	}
+}
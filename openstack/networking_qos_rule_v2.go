@@ -0,0 +1,39 @@
+package openstack
+
+import (
+	"fmt"
+	"strings"
+)
+
+// networkingQoSRuleV2ID builds the ID of a QoS rule resource. QoS rules can
+// only be retrieved through their policy, so the policy ID is part of the ID.
+func networkingQoSRuleV2ID(qosPolicyID, qosRuleID string) string {
+	return fmt.Sprintf("%s/%s", qosPolicyID, qosRuleID)
+}
+
+// parseNetworkingQoSRuleV2ID splits a QoS rule resource ID into the policy
+// ID and the rule ID.
+func parseNetworkingQoSRuleV2ID(id string) (string, string, error) {
+	parts := strings.SplitN(id, "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("Invalid format specified for QoS rule. Format must be <qos policy id>/<rule id>")
+	}
+
+	return parts[0], parts[1], nil
+}
+
+// validateNetworkingQoSRuleV2DSCPMark checks that the value is one of the
+// DSCP marks accepted by Neutron.
+func validateNetworkingQoSRuleV2DSCPMark(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(int)
+	for _, mark := range []int{
+		0, 8, 10, 12, 14, 16, 18, 20, 22, 24, 26, 28, 30, 32, 34, 36, 38, 40, 46, 48, 56,
+	} {
+		if value == mark {
+			return
+		}
+	}
+
+	errors = append(errors, fmt.Errorf("%q contains an invalid DSCP mark: %d", k, value))
+	return
+}
@@ -0,0 +1,32 @@
+package openstack
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNetworkingQoSRuleV2ID(t *testing.T) {
+	id := networkingQoSRuleV2ID("policy", "rule")
+	assert.Equal(t, "policy/rule", id)
+
+	qosPolicyID, qosRuleID, err := parseNetworkingQoSRuleV2ID(id)
+	assert.NoError(t, err)
+	assert.Equal(t, "policy", qosPolicyID)
+	assert.Equal(t, "rule", qosRuleID)
+}
+
+func TestParseNetworkingQoSRuleV2ID_invalid(t *testing.T) {
+	for _, id := range []string{"rule", "policy/", "/rule"} {
+		_, _, err := parseNetworkingQoSRuleV2ID(id)
+		assert.Error(t, err, id)
+	}
+}
+
+func TestValidateNetworkingQoSRuleV2DSCPMark(t *testing.T) {
+	_, errs := validateNetworkingQoSRuleV2DSCPMark(26, "dscp_mark")
+	assert.Empty(t, errs)
+
+	_, errs = validateNetworkingQoSRuleV2DSCPMark(27, "dscp_mark")
+	assert.Len(t, errs, 1)
+}
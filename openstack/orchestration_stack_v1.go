@@ -0,0 +1,149 @@
+package openstack
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/samuelbernardolip/gophercloud"
+	"github.com/samuelbernardolip/gophercloud/openstack/orchestration/v1/stacks"
+	yaml "gopkg.in/yaml.v2"
+)
+
+func orchestrationStackV1Tags(d *schema.ResourceData) []string {
+	var tags []string
+	for _, tag := range d.Get("tags").(*schema.Set).List() {
+		tags = append(tags, tag.(string))
+	}
+
+	return tags
+}
+
+func orchestrationStackV1Files(d *schema.ResourceData) map[string]string {
+	files := make(map[string]string)
+	for k, v := range d.Get("files").(map[string]interface{}) {
+		files[k] = v.(string)
+	}
+
+	return files
+}
+
+// orchestrationStackV1Parameters returns the user-defined stack parameters,
+// skipping the pseudo parameters which are set by Heat itself.
+func orchestrationStackV1Parameters(parameters map[string]string) map[string]string {
+	m := make(map[string]string)
+	for k, v := range parameters {
+		if strings.HasPrefix(k, "OS::") {
+			continue
+		}
+		m[k] = v
+	}
+
+	return m
+}
+
+// orchestrationStackV1ConfiguredParameters returns the stack parameters which
+// are set in the configuration. Heat reports the values of all template
+// parameters, including the defaults that weren't explicitly set.
+func orchestrationStackV1ConfiguredParameters(d *schema.ResourceData, parameters map[string]string) map[string]string {
+	configured := d.Get("parameters").(map[string]interface{})
+
+	m := make(map[string]string)
+	for k, v := range parameters {
+		if _, ok := configured[k]; ok {
+			m[k] = v
+		}
+	}
+
+	return m
+}
+
+func flattenOrchestrationStackV1Outputs(outputs []stacks.Output) map[string]string {
+	m := make(map[string]string)
+	for _, output := range outputs {
+		if output.OutputError != "" {
+			log.Printf("[DEBUG] Unable to resolve output %s of openstack_orchestration_stack_v1: %s", output.OutputKey, output.OutputError)
+		}
+
+		switch v := output.OutputValue.(type) {
+		case nil:
+			m[output.OutputKey] = ""
+		case string:
+			m[output.OutputKey] = v
+		default:
+			value, err := json.Marshal(v)
+			if err != nil {
+				log.Printf("[DEBUG] Unable to marshal output %s of openstack_orchestration_stack_v1: %s", output.OutputKey, err)
+				continue
+			}
+			m[output.OutputKey] = string(value)
+		}
+	}
+
+	return m
+}
+
+// orchestrationStackV1StateRefreshFunc returns an error when the given stack
+// action, e.g. CREATE or UPDATE, has failed or the stack has been rolled back.
+// For updates, lastUpdated is the updated_time of the stack before the update
+// was requested.
+func orchestrationStackV1StateRefreshFunc(client *gophercloud.ServiceClient, stackID, action string, lastUpdated time.Time) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		stack, err := stacks.Find(client, stackID).Extract()
+		if err != nil {
+			if _, ok := err.(gophercloud.ErrDefault404); ok {
+				return stack, "DELETE_COMPLETE", nil
+			}
+
+			return nil, "", err
+		}
+
+		log.Printf("[DEBUG] openstack_orchestration_stack_v1 %s status: %s", stackID, stack.Status)
+
+		status, err := orchestrationStackV1Status(stack, action, lastUpdated)
+		return stack, status, err
+	}
+}
+
+// orchestrationStackV1Status returns the status of a stack for the given
+// action. Right after an update has been requested, the stack still reports
+// the status of its previous action. Until Heat records a newer updated_time,
+// the update is reported as UPDATE_PENDING.
+func orchestrationStackV1Status(stack *stacks.RetrievedStack, action string, lastUpdated time.Time) (string, error) {
+	if action == "UPDATE" && stack.Status != "UPDATE_IN_PROGRESS" && !stack.UpdatedTime.After(lastUpdated) {
+		return "UPDATE_PENDING", nil
+	}
+
+	failed := stack.Status == action+"_FAILED" || stack.Status == "ROLLBACK_FAILED"
+	if action != "DELETE" && stack.Status == "ROLLBACK_COMPLETE" {
+		failed = true
+	}
+
+	if failed {
+		return stack.Status, fmt.Errorf("openstack_orchestration_stack_v1 %s is in %s status: %s", stack.ID, stack.Status, stack.StatusReason)
+	}
+
+	return stack.Status, nil
+}
+
+// suppressOrchestrationStackV1TemplateDiffs suppresses the differences between
+// templates which are semantically equal, e.g. a YAML template and its JSON
+// representation returned by the API.
+func suppressOrchestrationStackV1TemplateDiffs(k, old, new string, d *schema.ResourceData) bool {
+	var oldTemplate, newTemplate interface{}
+
+	if err := yaml.Unmarshal([]byte(old), &oldTemplate); err != nil {
+		return false
+	}
+
+	if err := yaml.Unmarshal([]byte(new), &newTemplate); err != nil {
+		return false
+	}
+
+	return reflect.DeepEqual(oldTemplate, newTemplate)
+}
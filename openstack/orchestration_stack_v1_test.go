@@ -0,0 +1,102 @@
+package openstack
+
+import (
+	"testing"
+	"time"
+
+	"github.com/samuelbernardolip/gophercloud/openstack/orchestration/v1/stacks"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFlattenOrchestrationStackV1Outputs(t *testing.T) {
+	outputs := []stacks.Output{
+		{
+			OutputKey:   "ip",
+			OutputValue: "192.168.199.10",
+		},
+		{
+			OutputKey:   "ports",
+			OutputValue: []interface{}{"a", "b"},
+		},
+		{
+			OutputKey:   "unresolved",
+			OutputValue: nil,
+			OutputError: "Resource not found",
+		},
+	}
+
+	expected := map[string]string{
+		"ip":         "192.168.199.10",
+		"ports":      `["a","b"]`,
+		"unresolved": "",
+	}
+
+	actual := flattenOrchestrationStackV1Outputs(outputs)
+	assert.Equal(t, expected, actual)
+}
+
+func TestOrchestrationStackV1Parameters(t *testing.T) {
+	parameters := map[string]string{
+		"OS::stack_id":   "3095aefc-09fb-4bc7-b1f0-f21a304e864c",
+		"OS::stack_name": "stack_1",
+		"flavor":         "m1.small",
+	}
+
+	expected := map[string]string{
+		"flavor": "m1.small",
+	}
+
+	actual := orchestrationStackV1Parameters(parameters)
+	assert.Equal(t, expected, actual)
+}
+
+func TestSuppressOrchestrationStackV1TemplateDiffs(t *testing.T) {
+	yamlTemplate := `
+heat_template_version: 2015-04-30
+resources:
+  random:
+    type: OS::Heat::RandomString
+    properties:
+      length: 8
+`
+	jsonTemplate := `{"heat_template_version":"2015-04-30","resources":{"random":{"properties":{"length":8},"type":"OS::Heat::RandomString"}}}`
+	otherTemplate := `{"heat_template_version":"2015-04-30","resources":{"random":{"properties":{"length":16},"type":"OS::Heat::RandomString"}}}`
+
+	assert.True(t, suppressOrchestrationStackV1TemplateDiffs("template", yamlTemplate, jsonTemplate, nil))
+	assert.False(t, suppressOrchestrationStackV1TemplateDiffs("template", jsonTemplate, otherTemplate, nil))
+}
+
+func TestOrchestrationStackV1Status(t *testing.T) {
+	lastUpdated := time.Date(2018, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	stack := &stacks.RetrievedStack{
+		ID:          "stack_1",
+		Status:      "UPDATE_COMPLETE",
+		UpdatedTime: lastUpdated,
+	}
+
+	status, err := orchestrationStackV1Status(stack, "UPDATE", lastUpdated)
+	assert.NoError(t, err)
+	assert.Equal(t, "UPDATE_PENDING", status)
+
+	stack.Status = "UPDATE_IN_PROGRESS"
+	status, err = orchestrationStackV1Status(stack, "UPDATE", lastUpdated)
+	assert.NoError(t, err)
+	assert.Equal(t, "UPDATE_IN_PROGRESS", status)
+
+	stack.Status = "UPDATE_COMPLETE"
+	stack.UpdatedTime = lastUpdated.Add(time.Minute)
+	status, err = orchestrationStackV1Status(stack, "UPDATE", lastUpdated)
+	assert.NoError(t, err)
+	assert.Equal(t, "UPDATE_COMPLETE", status)
+
+	stack.Status = "ROLLBACK_COMPLETE"
+	status, err = orchestrationStackV1Status(stack, "UPDATE", lastUpdated)
+	assert.Error(t, err)
+	assert.Equal(t, "ROLLBACK_COMPLETE", status)
+
+	stack.Status = "ROLLBACK_IN_PROGRESS"
+	status, err = orchestrationStackV1Status(stack, "CREATE", time.Time{})
+	assert.NoError(t, err)
+	assert.Equal(t, "ROLLBACK_IN_PROGRESS", status)
+}
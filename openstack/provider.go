@@ -203,13 +203,72 @@ func Provider() terraform.ResourceProvider {
 				DefaultFunc: schema.EnvDefaultFunc("OS_IDENTITY_PROVIDER", ""),
 				Description: descriptions["oidc_idp"],
 			},
+
+			"application_credential_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("OS_APPLICATION_CREDENTIAL_ID", ""),
+				Description: descriptions["application_credential_id"],
+			},
+
+			"application_credential_name": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("OS_APPLICATION_CREDENTIAL_NAME", ""),
+				Description: descriptions["application_credential_name"],
+			},
+
+			"application_credential_secret": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				DefaultFunc: schema.EnvDefaultFunc("OS_APPLICATION_CREDENTIAL_SECRET", ""),
+				Description: descriptions["application_credential_secret"],
+			},
+
+			"max_retries": &schema.Schema{
+				Type:        schema.TypeInt,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("OS_MAX_RETRIES", 0),
+				Description: descriptions["max_retries"],
+			},
+
+			"min_retry_backoff": &schema.Schema{
+				Type:        schema.TypeInt,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("OS_MIN_RETRY_BACKOFF", 1),
+				Description: descriptions["min_retry_backoff"],
+			},
+
+			"max_retry_backoff": &schema.Schema{
+				Type:        schema.TypeInt,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("OS_MAX_RETRY_BACKOFF", 30),
+				Description: descriptions["max_retry_backoff"],
+			},
+
+			"token_cache_path": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("OS_TOKEN_CACHE_PATH", ""),
+				Description: descriptions["token_cache_path"],
+			},
+
+			"endpoint_overrides": &schema.Schema{
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: descriptions["endpoint_overrides"],
+			},
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
 			"openstack_blockstorage_snapshot_v2":          dataSourceBlockStorageSnapshotV2(),
 			"openstack_blockstorage_snapshot_v3":          dataSourceBlockStorageSnapshotV3(),
+			"openstack_blockstorage_quotaset_v3":          dataSourceBlockStorageQuotasetV3(),
 			"openstack_compute_flavor_v2":                 dataSourceComputeFlavorV2(),
 			"openstack_compute_keypair_v2":                dataSourceComputeKeypairV2(),
+			"openstack_compute_quotaset_v2":               dataSourceComputeQuotasetV2(),
 			"openstack_containerinfra_clustertemplate_v1": dataSourceContainerInfraClusterTemplateV1(),
 			"openstack_containerinfra_cluster_v1":         dataSourceContainerInfraCluster(),
 			"openstack_dns_zone_v2":                       dataSourceDNSZoneV2(),
@@ -221,76 +280,114 @@ func Provider() terraform.ResourceProvider {
 			"openstack_identity_endpoint_v3":              dataSourceIdentityEndpointV3(),
 			"openstack_identity_group_v3":                 dataSourceIdentityGroupV3(),
 			"openstack_images_image_v2":                   dataSourceImagesImageV2(),
+			"openstack_keymanager_secret_v1":              dataSourceKeyManagerSecretV1(),
+			"openstack_keymanager_container_v1":           dataSourceKeyManagerContainerV1(),
 			"openstack_networking_network_v2":             dataSourceNetworkingNetworkV2(),
+			"openstack_networking_quota_v2":               dataSourceNetworkingQuotaV2(),
 			"openstack_networking_subnet_v2":              dataSourceNetworkingSubnetV2(),
 			"openstack_networking_secgroup_v2":            dataSourceNetworkingSecGroupV2(),
 			"openstack_networking_subnetpool_v2":          dataSourceNetworkingSubnetPoolV2(),
 			"openstack_networking_floatingip_v2":          dataSourceNetworkingFloatingIPV2(),
 			"openstack_networking_router_v2":              dataSourceNetworkingRouterV2(),
+			"openstack_orchestration_stack_v1":            dataSourceOrchestrationStackV1(),
 		},
 
 		ResourcesMap: map[string]*schema.Resource{
-			"openstack_blockstorage_volume_v1":             resourceBlockStorageVolumeV1(),
-			"openstack_blockstorage_volume_v2":             resourceBlockStorageVolumeV2(),
-			"openstack_blockstorage_volume_v3":             resourceBlockStorageVolumeV3(),
-			"openstack_blockstorage_volume_attach_v2":      resourceBlockStorageVolumeAttachV2(),
-			"openstack_blockstorage_volume_attach_v3":      resourceBlockStorageVolumeAttachV3(),
-			"openstack_compute_flavor_v2":                  resourceComputeFlavorV2(),
-			"openstack_compute_flavor_access_v2":           resourceComputeFlavorAccessV2(),
-			"openstack_compute_instance_v2":                resourceComputeInstanceV2(),
-			"openstack_compute_interface_attach_v2":        resourceComputeInterfaceAttachV2(),
-			"openstack_compute_keypair_v2":                 resourceComputeKeypairV2(),
-			"openstack_compute_secgroup_v2":                resourceComputeSecGroupV2(),
-			"openstack_compute_servergroup_v2":             resourceComputeServerGroupV2(),
-			"openstack_compute_floatingip_v2":              resourceComputeFloatingIPV2(),
-			"openstack_compute_floatingip_associate_v2":    resourceComputeFloatingIPAssociateV2(),
-			"openstack_compute_volume_attach_v2":           resourceComputeVolumeAttachV2(),
-			"openstack_containerinfra_clustertemplate_v1":  resourceContainerInfraClusterTemplateV1(),
-			"openstack_containerinfra_cluster_v1":          resourceContainerInfraClusterV1(),
-			"openstack_db_instance_v1":                     resourceDatabaseInstanceV1(),
-			"openstack_db_user_v1":                         resourceDatabaseUserV1(),
-			"openstack_db_configuration_v1":                resourceDatabaseConfigurationV1(),
-			"openstack_db_database_v1":                     resourceDatabaseDatabaseV1(),
-			"openstack_dns_recordset_v2":                   resourceDNSRecordSetV2(),
-			"openstack_dns_zone_v2":                        resourceDNSZoneV2(),
-			"openstack_fw_firewall_v1":                     resourceFWFirewallV1(),
-			"openstack_fw_policy_v1":                       resourceFWPolicyV1(),
-			"openstack_fw_rule_v1":                         resourceFWRuleV1(),
-			"openstack_identity_project_v3":                resourceIdentityProjectV3(),
-			"openstack_identity_role_v3":                   resourceIdentityRoleV3(),
-			"openstack_identity_role_assignment_v3":        resourceIdentityRoleAssignmentV3(),
-			"openstack_identity_user_v3":                   resourceIdentityUserV3(),
-			"openstack_images_image_v2":                    resourceImagesImageV2(),
-			"openstack_lb_member_v1":                       resourceLBMemberV1(),
-			"openstack_lb_monitor_v1":                      resourceLBMonitorV1(),
-			"openstack_lb_pool_v1":                         resourceLBPoolV1(),
-			"openstack_lb_vip_v1":                          resourceLBVipV1(),
-			"openstack_lb_loadbalancer_v2":                 resourceLoadBalancerV2(),
-			"openstack_lb_listener_v2":                     resourceListenerV2(),
-			"openstack_lb_pool_v2":                         resourcePoolV2(),
-			"openstack_lb_member_v2":                       resourceMemberV2(),
-			"openstack_lb_monitor_v2":                      resourceMonitorV2(),
-			"openstack_networking_floatingip_v2":           resourceNetworkingFloatingIPV2(),
-			"openstack_networking_floatingip_associate_v2": resourceNetworkingFloatingIPAssociateV2(),
-			"openstack_networking_network_v2":              resourceNetworkingNetworkV2(),
-			"openstack_networking_port_v2":                 resourceNetworkingPortV2(),
-			"openstack_networking_router_v2":               resourceNetworkingRouterV2(),
-			"openstack_networking_router_interface_v2":     resourceNetworkingRouterInterfaceV2(),
-			"openstack_networking_router_route_v2":         resourceNetworkingRouterRouteV2(),
-			"openstack_networking_secgroup_v2":             resourceNetworkingSecGroupV2(),
-			"openstack_networking_secgroup_rule_v2":        resourceNetworkingSecGroupRuleV2(),
-			"openstack_networking_subnet_v2":               resourceNetworkingSubnetV2(),
-			"openstack_networking_subnet_route_v2":         resourceNetworkingSubnetRouteV2(),
-			"openstack_networking_subnetpool_v2":           resourceNetworkingSubnetPoolV2(),
-			"openstack_networking_trunk_v2":                resourceNetworkingTrunkV2(),
-			"openstack_objectstorage_container_v1":         resourceObjectStorageContainerV1(),
-			"openstack_objectstorage_object_v1":            resourceObjectStorageObjectV1(),
-			"openstack_objectstorage_tempurl_v1":           resourceObjectstorageTempurlV1(),
-			"openstack_vpnaas_ipsec_policy_v2":             resourceIPSecPolicyV2(),
-			"openstack_vpnaas_service_v2":                  resourceServiceV2(),
-			"openstack_vpnaas_ike_policy_v2":               resourceIKEPolicyV2(),
-			"openstack_vpnaas_endpoint_group_v2":           resourceEndpointGroupV2(),
-			"openstack_vpnaas_site_connection_v2":          resourceSiteConnectionV2(),
+			"openstack_blockstorage_volume_v1":                   resourceBlockStorageVolumeV1(),
+			"openstack_blockstorage_volume_v2":                   resourceBlockStorageVolumeV2(),
+			"openstack_blockstorage_volume_v3":                   resourceBlockStorageVolumeV3(),
+			"openstack_blockstorage_volume_upload_image_v3":      resourceBlockStorageVolumeUploadImageV3(),
+			"openstack_blockstorage_snapshot_v3":                 resourceBlockStorageSnapshotV3(),
+			"openstack_blockstorage_backup_v3":                   resourceBlockStorageBackupV3(),
+			"openstack_blockstorage_volume_type_v3":              resourceBlockStorageVolumeTypeV3(),
+			"openstack_blockstorage_volume_type_encryption_v3":   resourceBlockStorageVolumeTypeEncryptionV3(),
+			"openstack_blockstorage_qos_v3":                      resourceBlockStorageQoSV3(),
+			"openstack_blockstorage_volume_attach_v2":            resourceBlockStorageVolumeAttachV2(),
+			"openstack_blockstorage_volume_attach_v3":            resourceBlockStorageVolumeAttachV3(),
+			"openstack_blockstorage_quotaset_v3":                 resourceBlockStorageQuotasetV3(),
+			"openstack_compute_flavor_v2":                        resourceComputeFlavorV2(),
+			"openstack_compute_flavor_access_v2":                 resourceComputeFlavorAccessV2(),
+			"openstack_compute_instance_v2":                      resourceComputeInstanceV2(),
+			"openstack_compute_instance_snapshot_v2":             resourceComputeInstanceSnapshotV2(),
+			"openstack_compute_interface_attach_v2":              resourceComputeInterfaceAttachV2(),
+			"openstack_compute_keypair_v2":                       resourceComputeKeypairV2(),
+			"openstack_compute_secgroup_v2":                      resourceComputeSecGroupV2(),
+			"openstack_compute_servergroup_v2":                   resourceComputeServerGroupV2(),
+			"openstack_compute_floatingip_v2":                    resourceComputeFloatingIPV2(),
+			"openstack_compute_floatingip_associate_v2":          resourceComputeFloatingIPAssociateV2(),
+			"openstack_compute_volume_attach_v2":                 resourceComputeVolumeAttachV2(),
+			"openstack_compute_quotaset_v2":                      resourceComputeQuotasetV2(),
+			"openstack_containerinfra_clustertemplate_v1":        resourceContainerInfraClusterTemplateV1(),
+			"openstack_containerinfra_cluster_v1":                resourceContainerInfraClusterV1(),
+			"openstack_db_instance_v1":                           resourceDatabaseInstanceV1(),
+			"openstack_db_user_v1":                               resourceDatabaseUserV1(),
+			"openstack_db_configuration_v1":                      resourceDatabaseConfigurationV1(),
+			"openstack_db_database_v1":                           resourceDatabaseDatabaseV1(),
+			"openstack_dns_recordset_v2":                         resourceDNSRecordSetV2(),
+			"openstack_dns_zone_v2":                              resourceDNSZoneV2(),
+			"openstack_fw_firewall_v1":                           resourceFWFirewallV1(),
+			"openstack_fw_policy_v1":                             resourceFWPolicyV1(),
+			"openstack_fw_rule_v1":                               resourceFWRuleV1(),
+			"openstack_identity_application_credential_v3":       resourceIdentityApplicationCredentialV3(),
+			"openstack_identity_domain_v3":                       resourceIdentityDomainV3(),
+			"openstack_identity_endpoint_v3":                     resourceIdentityEndpointV3(),
+			"openstack_identity_group_v3":                        resourceIdentityGroupV3(),
+			"openstack_identity_project_v3":                      resourceIdentityProjectV3(),
+			"openstack_identity_region_v3":                       resourceIdentityRegionV3(),
+			"openstack_identity_role_v3":                         resourceIdentityRoleV3(),
+			"openstack_identity_role_assignment_v3":              resourceIdentityRoleAssignmentV3(),
+			"openstack_identity_service_v3":                      resourceIdentityServiceV3(),
+			"openstack_identity_user_v3":                         resourceIdentityUserV3(),
+			"openstack_identity_user_membership_v3":              resourceIdentityUserMembershipV3(),
+			"openstack_images_image_v2":                          resourceImagesImageV2(),
+			"openstack_images_image_access_v2":                   resourceImagesImageAccessV2(),
+			"openstack_images_image_access_accept_v2":            resourceImagesImageAccessAcceptV2(),
+			"openstack_keymanager_secret_v1":                     resourceKeyManagerSecretV1(),
+			"openstack_keymanager_container_v1":                  resourceKeyManagerContainerV1(),
+			"openstack_lb_member_v1":                             resourceLBMemberV1(),
+			"openstack_lb_monitor_v1":                            resourceLBMonitorV1(),
+			"openstack_lb_pool_v1":                               resourceLBPoolV1(),
+			"openstack_lb_vip_v1":                                resourceLBVipV1(),
+			"openstack_lb_loadbalancer_v2":                       resourceLoadBalancerV2(),
+			"openstack_lb_listener_v2":                           resourceListenerV2(),
+			"openstack_lb_pool_v2":                               resourcePoolV2(),
+			"openstack_lb_member_v2":                             resourceMemberV2(),
+			"openstack_lb_monitor_v2":                            resourceMonitorV2(),
+			"openstack_lb_l7policy_v2":                           resourceL7PolicyV2(),
+			"openstack_lb_l7rule_v2":                             resourceL7RuleV2(),
+			"openstack_lb_quota_v2":                              resourceLBQuotaV2(),
+			"openstack_networking_floatingip_v2":                 resourceNetworkingFloatingIPV2(),
+			"openstack_networking_floatingip_associate_v2":       resourceNetworkingFloatingIPAssociateV2(),
+			"openstack_networking_network_v2":                    resourceNetworkingNetworkV2(),
+			"openstack_networking_port_v2":                       resourceNetworkingPortV2(),
+			"openstack_networking_qos_policy_v2":                 resourceNetworkingQoSPolicyV2(),
+			"openstack_networking_qos_bandwidth_limit_rule_v2":   resourceNetworkingQoSBandwidthLimitRuleV2(),
+			"openstack_networking_qos_dscp_marking_rule_v2":      resourceNetworkingQoSDSCPMarkingRuleV2(),
+			"openstack_networking_qos_minimum_bandwidth_rule_v2": resourceNetworkingQoSMinimumBandwidthRuleV2(),
+			"openstack_networking_rbac_policy_v2":                resourceNetworkingRBACPolicyV2(),
+			"openstack_networking_quota_v2":                      resourceNetworkingQuotaV2(),
+			"openstack_networking_router_v2":                     resourceNetworkingRouterV2(),
+			"openstack_networking_router_interface_v2":           resourceNetworkingRouterInterfaceV2(),
+			"openstack_networking_router_route_v2":               resourceNetworkingRouterRouteV2(),
+			"openstack_networking_secgroup_v2":                   resourceNetworkingSecGroupV2(),
+			"openstack_networking_secgroup_rule_v2":              resourceNetworkingSecGroupRuleV2(),
+			"openstack_networking_subnet_v2":                     resourceNetworkingSubnetV2(),
+			"openstack_networking_subnet_route_v2":               resourceNetworkingSubnetRouteV2(),
+			"openstack_networking_subnetpool_v2":                 resourceNetworkingSubnetPoolV2(),
+			"openstack_networking_trunk_v2":                      resourceNetworkingTrunkV2(),
+			"openstack_objectstorage_container_v1":               resourceObjectStorageContainerV1(),
+			"openstack_objectstorage_object_v1":                  resourceObjectStorageObjectV1(),
+			"openstack_objectstorage_tempurl_v1":                 resourceObjectstorageTempurlV1(),
+			"openstack_orchestration_stack_v1":                   resourceOrchestrationStackV1(),
+			"openstack_sharedfilesystem_securityservice_v2":      resourceSharedFilesystemSecurityServiceV2(),
+			"openstack_sharedfilesystem_sharenetwork_v2":         resourceSharedFilesystemShareNetworkV2(),
+			"openstack_sharedfilesystem_share_v2":                resourceSharedFilesystemShareV2(),
+			"openstack_sharedfilesystem_share_access_v2":         resourceSharedFilesystemShareAccessV2(),
+			"openstack_vpnaas_ipsec_policy_v2":                   resourceIPSecPolicyV2(),
+			"openstack_vpnaas_service_v2":                        resourceServiceV2(),
+			"openstack_vpnaas_ike_policy_v2":                     resourceIKEPolicyV2(),
+			"openstack_vpnaas_endpoint_group_v2":                 resourceEndpointGroupV2(),
+			"openstack_vpnaas_site_connection_v2":                resourceSiteConnectionV2(),
 		},
 
 		ConfigureFunc: configureProvider,
@@ -356,36 +453,63 @@ func init() {
 		"oidc_protocol": "The OIDC protocol.",
 
 		"oidc_idp": "The OIDC identity provider.",
+
+		"application_credential_id": "Application Credential ID to login with.",
+
+		"application_credential_name": "Application Credential name to login with.",
+
+		"application_credential_secret": "Application Credential secret to login with.",
+
+		"max_retries": "How many times idempotent HTTP requests are retried after a\n" +
+			"throttled or transient API error.",
+
+		"min_retry_backoff": "The minimum number of seconds to wait before retrying a request.",
+
+		"max_retry_backoff": "The maximum number of seconds to wait before retrying a request.",
+
+		"token_cache_path": "A file in which Identity v3 tokens are cached and reused\n" +
+			"across provider runs.",
+
+		"endpoint_overrides": "A map of service types to endpoints which override the\n" +
+			"endpoints of the Keystone catalog.",
 	}
 }
 
 func configureProvider(d *schema.ResourceData) (interface{}, error) {
 	config := Config{
-		CACertFile:        d.Get("cacert_file").(string),
-		ClientCertFile:    d.Get("cert").(string),
-		ClientKeyFile:     d.Get("key").(string),
-		Cloud:             d.Get("cloud").(string),
-		DefaultDomain:     d.Get("default_domain").(string),
-		DomainID:          d.Get("domain_id").(string),
-		DomainName:        d.Get("domain_name").(string),
-		EndpointType:      d.Get("endpoint_type").(string),
-		IdentityEndpoint:  d.Get("auth_url").(string),
-		Password:          d.Get("password").(string),
-		ProjectDomainID:   d.Get("project_domain_id").(string),
-		ProjectDomainName: d.Get("project_domain_name").(string),
-		Region:            d.Get("region").(string),
-		Swauth:            d.Get("swauth").(bool),
-		Token:             d.Get("token").(string),
-		TenantID:          d.Get("tenant_id").(string),
-		TenantName:        d.Get("tenant_name").(string),
-		UserDomainID:      d.Get("user_domain_id").(string),
-		UserDomainName:    d.Get("user_domain_name").(string),
-		Username:          d.Get("user_name").(string),
-		UserID:            d.Get("user_id").(string),
-		useOctavia:        d.Get("use_octavia").(bool),
-		oidcToken:         d.Get("oidc_token").(string),
-		oidcProtocol:      d.Get("oidc_protocol").(string),
-		oidcIDP:           d.Get("oidc_idp").(string),
+		CACertFile:                  d.Get("cacert_file").(string),
+		ClientCertFile:              d.Get("cert").(string),
+		ClientKeyFile:               d.Get("key").(string),
+		Cloud:                       d.Get("cloud").(string),
+		DefaultDomain:               d.Get("default_domain").(string),
+		DomainID:                    d.Get("domain_id").(string),
+		DomainName:                  d.Get("domain_name").(string),
+		EndpointType:                d.Get("endpoint_type").(string),
+		IdentityEndpoint:            d.Get("auth_url").(string),
+		Password:                    d.Get("password").(string),
+		ProjectDomainID:             d.Get("project_domain_id").(string),
+		ProjectDomainName:           d.Get("project_domain_name").(string),
+		Region:                      d.Get("region").(string),
+		Swauth:                      d.Get("swauth").(bool),
+		Token:                       d.Get("token").(string),
+		TenantID:                    d.Get("tenant_id").(string),
+		TenantName:                  d.Get("tenant_name").(string),
+		UserDomainID:                d.Get("user_domain_id").(string),
+		UserDomainName:              d.Get("user_domain_name").(string),
+		Username:                    d.Get("user_name").(string),
+		UserID:                      d.Get("user_id").(string),
+		useOctavia:                  d.Get("use_octavia").(bool),
+		oidcToken:                   d.Get("oidc_token").(string),
+		oidcProtocol:                d.Get("oidc_protocol").(string),
+		oidcIDP:                     d.Get("oidc_idp").(string),
+		applicationCredentialID:     d.Get("application_credential_id").(string),
+		applicationCredentialName:   d.Get("application_credential_name").(string),
+		applicationCredentialSecret: d.Get("application_credential_secret").(string),
+		endpointOverrides:           d.Get("endpoint_overrides").(map[string]interface{}),
+		maxRetries:                  d.Get("max_retries").(int),
+		minRetryBackoff:             d.Get("min_retry_backoff").(int),
+		maxRetryBackoff:             d.Get("max_retry_backoff").(int),
+		tokenCachePath:              d.Get("token_cache_path").(string),
 	}
 
 	v, ok := d.GetOkExists("insecure")
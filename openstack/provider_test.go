@@ -37,6 +37,10 @@ var (
 	OS_PROTOCOL                    = os.Getenv("OS_PROTOCOL")
 	OS_IDENTITY_PROVIDER           = os.Getenv("OS_IDENTITY_PROVIDER")
 	OS_AUTH_TYPE                   = os.Getenv("OS_AUTH_TYPE")
+	OS_KEYMANAGER_ENVIRONMENT      = os.Getenv("OS_KEYMANAGER_ENVIRONMENT")
+	OS_ORCHESTRATION_ENVIRONMENT   = os.Getenv("OS_ORCHESTRATION_ENVIRONMENT")
+	OS_SFS_ENVIRONMENT             = os.Getenv("OS_SFS_ENVIRONMENT")
+	OS_BACKUP_ENVIRONMENT          = os.Getenv("OS_BACKUP_ENVIRONMENT")
 )
 
 var testAccProviders map[string]terraform.ResourceProvider
@@ -149,6 +153,38 @@ func testAccPreCheckContainerInfra(t *testing.T) {
 	}
 }
 
+func testAccPreCheckKeyManager(t *testing.T) {
+	testAccPreCheckRequiredEnvVars(t)
+
+	if OS_KEYMANAGER_ENVIRONMENT == "" {
+		t.Skip("This environment does not support Barbican Key Manager tests")
+	}
+}
+
+func testAccPreCheckOrchestration(t *testing.T) {
+	testAccPreCheckRequiredEnvVars(t)
+
+	if OS_ORCHESTRATION_ENVIRONMENT == "" {
+		t.Skip("This environment does not support Orchestration tests")
+	}
+}
+
+func testAccPreCheckSFS(t *testing.T) {
+	testAccPreCheckRequiredEnvVars(t)
+
+	if OS_SFS_ENVIRONMENT == "" {
+		t.Skip("This environment does not support Shared File Systems tests")
+	}
+}
+
+func testAccPreCheckBackup(t *testing.T) {
+	testAccPreCheckRequiredEnvVars(t)
+
+	if OS_BACKUP_ENVIRONMENT == "" {
+		t.Skip("This environment does not support Block Storage Backup tests")
+	}
+}
+
 func testAccPreOnlineResize(t *testing.T) {
 	testAccPreCheckRequiredEnvVars(t)
 
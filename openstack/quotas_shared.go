@@ -0,0 +1,38 @@
+package openstack
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// quotaValue returns a pointer to the value of the quota attribute key when it
+// is set in the configuration, so that only explicitly managed quotas are sent
+// to the API. A value of 0 is a valid quota and is returned as well.
+func quotaValue(d *schema.ResourceData, key string) *int {
+	if v, ok := d.GetOkExists(key); ok {
+		value := v.(int)
+		return &value
+	}
+
+	return nil
+}
+
+// quotaChangedValue returns a pointer to the value of the quota attribute key
+// when it has changed, and nil otherwise.
+func quotaChangedValue(d *schema.ResourceData, key string) *int {
+	if d.HasChange(key) {
+		value := d.Get(key).(int)
+		return &value
+	}
+
+	return nil
+}
+
+// quotaUsageSchema returns the schema of the maps exported by the quota
+// data sources to report the usage of every quota of a project.
+func quotaUsageSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeMap,
+		Computed: true,
+		Elem:     &schema.Schema{Type: schema.TypeInt},
+	}
+}
@@ -0,0 +1,44 @@
+package openstack
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestQuotaValue(t *testing.T) {
+	r := resourceComputeQuotasetV2()
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"project_id": "project",
+		"cores":      0,
+		"instances":  10,
+	})
+
+	cores := quotaValue(d, "cores")
+	if assert.NotNil(t, cores) {
+		assert.Equal(t, 0, *cores)
+	}
+
+	instances := quotaValue(d, "instances")
+	if assert.NotNil(t, instances) {
+		assert.Equal(t, 10, *instances)
+	}
+
+	assert.Nil(t, quotaValue(d, "ram"))
+}
+
+func TestQuotaChangedValue(t *testing.T) {
+	r := resourceComputeQuotasetV2()
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"project_id": "project",
+		"cores":      4,
+	})
+
+	cores := quotaChangedValue(d, "cores")
+	if assert.NotNil(t, cores) {
+		assert.Equal(t, 4, *cores)
+	}
+
+	assert.Nil(t, quotaChangedValue(d, "ram"))
+}
@@ -0,0 +1,270 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/samuelbernardolip/gophercloud"
+	"github.com/samuelbernardolip/gophercloud/openstack/blockstorage/extensions/backups"
+)
+
+func resourceBlockStorageBackupV3() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBlockStorageBackupV3Create,
+		Read:   resourceBlockStorageBackupV3Read,
+		Update: resourceBlockStorageBackupV3Update,
+		Delete: resourceBlockStorageBackupV3Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"volume_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"snapshot_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: false,
+			},
+
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: false,
+			},
+
+			"container": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"incremental": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"force": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"metadata": &schema.Schema{
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: false,
+				Computed: true,
+			},
+
+			"size": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"object_count": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"status": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceBlockStorageBackupV3Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	blockStorageClient, err := config.blockStorageV3Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack block storage client: %s", err)
+	}
+
+	metadata := resourceContainerMetadataV2(d)
+	if len(metadata) > 0 {
+		blockStorageClient.Microversion = blockStorageBackupV3MetadataMicroversion
+	}
+
+	createOpts := &backups.CreateOpts{
+		VolumeID:    d.Get("volume_id").(string),
+		SnapshotID:  d.Get("snapshot_id").(string),
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+		Container:   d.Get("container").(string),
+		Incremental: d.Get("incremental").(bool),
+		Force:       d.Get("force").(bool),
+		Metadata:    metadata,
+	}
+
+	log.Printf("[DEBUG] Create Options: %#v", createOpts)
+	b, err := backups.Create(blockStorageClient, createOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack backup: %s", err)
+	}
+	log.Printf("[INFO] Backup ID: %s", b.ID)
+
+	// Store the ID now
+	d.SetId(b.ID)
+
+	// Wait for the backup to become available.
+	log.Printf("[DEBUG] Waiting for backup (%s) to become available", b.ID)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"creating"},
+		Target:     []string{"available"},
+		Refresh:    blockStorageBackupV3StateRefreshFunc(blockStorageClient, b.ID),
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		Delay:      10 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, err = stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf(
+			"Error waiting for backup (%s) to become ready: %s",
+			b.ID, err)
+	}
+
+	return resourceBlockStorageBackupV3Read(d, meta)
+}
+
+func resourceBlockStorageBackupV3Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	blockStorageClient, err := config.blockStorageV3Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack block storage client: %s", err)
+	}
+
+	// Backup metadata is only returned by newer microversions. Older Block
+	// Storage services reject them, so the backup is read without metadata.
+	blockStorageClient.Microversion = blockStorageBackupV3MetadataMicroversion
+	b, err := backups.Get(blockStorageClient, d.Id()).Extract()
+	if err != nil {
+		if _, ok := err.(gophercloud.ErrDefault404); ok {
+			return CheckDeleted(d, err, "backup")
+		}
+
+		log.Printf("[DEBUG] Unable to retrieve backup %s with microversion %s, retrying without: %s",
+			d.Id(), blockStorageBackupV3MetadataMicroversion, err)
+		blockStorageClient.Microversion = ""
+		b, err = backups.Get(blockStorageClient, d.Id()).Extract()
+		if err != nil {
+			return CheckDeleted(d, err, "backup")
+		}
+	}
+
+	log.Printf("[DEBUG] Retrieved backup %s: %+v", d.Id(), b)
+
+	d.Set("volume_id", b.VolumeID)
+	d.Set("snapshot_id", b.SnapshotID)
+	d.Set("name", b.Name)
+	d.Set("description", b.Description)
+	d.Set("container", b.Container)
+	d.Set("incremental", b.IsIncremental)
+	d.Set("metadata", b.Metadata)
+	d.Set("size", b.Size)
+	d.Set("object_count", b.ObjectCount)
+	d.Set("status", b.Status)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceBlockStorageBackupV3Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	blockStorageClient, err := config.blockStorageV3Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack block storage client: %s", err)
+	}
+
+	blockStorageClient.Microversion = blockStorageBackupV3UpdateMicroversion
+
+	var updateOpts blockStorageBackupV3UpdateOpts
+
+	if d.HasChange("name") {
+		name := d.Get("name").(string)
+		updateOpts.Name = &name
+	}
+
+	if d.HasChange("description") {
+		description := d.Get("description").(string)
+		updateOpts.Description = &description
+	}
+
+	if d.HasChange("metadata") {
+		metadata := resourceContainerMetadataV2(d)
+		updateOpts.Metadata = &metadata
+		blockStorageClient.Microversion = blockStorageBackupV3MetadataMicroversion
+	}
+
+	log.Printf("[DEBUG] Update Options: %#v", updateOpts)
+	_, err = backups.Update(blockStorageClient, d.Id(), updateOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error updating OpenStack backup %s: %s", d.Id(), err)
+	}
+
+	return resourceBlockStorageBackupV3Read(d, meta)
+}
+
+func resourceBlockStorageBackupV3Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	blockStorageClient, err := config.blockStorageV3Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack block storage client: %s", err)
+	}
+
+	if err := backups.Delete(blockStorageClient, d.Id()).ExtractErr(); err != nil {
+		return CheckDeleted(d, err, "backup")
+	}
+
+	// Wait for the backup to delete before moving on.
+	log.Printf("[DEBUG] Waiting for backup (%s) to delete", d.Id())
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"available", "deleting"},
+		Target:     []string{"deleted"},
+		Refresh:    blockStorageBackupV3StateRefreshFunc(blockStorageClient, d.Id()),
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		Delay:      10 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, err = stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf(
+			"Error waiting for backup (%s) to delete: %s",
+			d.Id(), err)
+	}
+
+	d.SetId("")
+	return nil
+}
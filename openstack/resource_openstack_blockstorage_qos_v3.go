@@ -0,0 +1,231 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/samuelbernardolip/gophercloud/openstack/blockstorage/v3/qos"
+)
+
+func resourceBlockStorageQoSV3() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBlockStorageQoSV3Create,
+		Read:   resourceBlockStorageQoSV3Read,
+		Update: resourceBlockStorageQoSV3Update,
+		Delete: resourceBlockStorageQoSV3Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"consumer": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: false,
+				Default:  string(qos.ConsumerBack),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(qos.ConsumerFront), string(qos.ConsumerBack), string(qos.ConsumerBoth),
+				}, false),
+			},
+
+			"specs": &schema.Schema{
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: false,
+			},
+
+			"volume_type_ids": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				ForceNew: false,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+		},
+	}
+}
+
+func resourceBlockStorageQoSV3Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	blockStorageClient, err := config.blockStorageV3Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack block storage client: %s", err)
+	}
+
+	createOpts := &qos.CreateOpts{
+		Name:     d.Get("name").(string),
+		Consumer: qos.QoSConsumer(d.Get("consumer").(string)),
+		Specs:    expandBlockStorageQoSV3Specs(d.Get("specs").(map[string]interface{})),
+	}
+
+	log.Printf("[DEBUG] Create Options: %#v", createOpts)
+	q, err := qos.Create(blockStorageClient, createOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack QoS specification: %s", err)
+	}
+	log.Printf("[INFO] QoS specification ID: %s", q.ID)
+
+	// Store the ID now
+	d.SetId(q.ID)
+
+	for _, volumeTypeID := range d.Get("volume_type_ids").(*schema.Set).List() {
+		associateOpts := qos.AssociateOpts{
+			VolumeTypeID: volumeTypeID.(string),
+		}
+		if err := qos.Associate(blockStorageClient, q.ID, associateOpts).ExtractErr(); err != nil {
+			return fmt.Errorf("Error associating OpenStack QoS specification %s with volume type %s: %s", q.ID, volumeTypeID, err)
+		}
+	}
+
+	return resourceBlockStorageQoSV3Read(d, meta)
+}
+
+func resourceBlockStorageQoSV3Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	blockStorageClient, err := config.blockStorageV3Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack block storage client: %s", err)
+	}
+
+	q, err := qos.Get(blockStorageClient, d.Id()).Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "QoS specification")
+	}
+
+	log.Printf("[DEBUG] Retrieved QoS specification %s: %+v", d.Id(), q)
+
+	d.Set("name", q.Name)
+	d.Set("consumer", q.Consumer)
+	d.Set("region", GetRegion(d, config))
+
+	if err := d.Set("specs", q.Specs); err != nil {
+		log.Printf("[WARN] Unable to set specs for QoS specification %s: %s", d.Id(), err)
+	}
+
+	allPages, err := qos.ListAssociations(blockStorageClient, d.Id()).AllPages()
+	if err != nil {
+		return fmt.Errorf("Error retrieving associations of OpenStack QoS specification %s: %s", d.Id(), err)
+	}
+
+	associations, err := qos.ExtractAssociations(allPages)
+	if err != nil {
+		return fmt.Errorf("Error extracting associations of OpenStack QoS specification %s: %s", d.Id(), err)
+	}
+
+	if err := d.Set("volume_type_ids", flattenBlockStorageQoSV3VolumeTypeIDs(associations)); err != nil {
+		log.Printf("[WARN] Unable to set volume_type_ids for QoS specification %s: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceBlockStorageQoSV3Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	blockStorageClient, err := config.blockStorageV3Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack block storage client: %s", err)
+	}
+
+	var hasChange bool
+	var updateOpts qos.UpdateOpts
+
+	if d.HasChange("consumer") {
+		hasChange = true
+		updateOpts.Consumer = qos.QoSConsumer(d.Get("consumer").(string))
+	}
+
+	if d.HasChange("specs") {
+		oldSpecs, newSpecs := d.GetChange("specs")
+		oldSpecsRaw := oldSpecs.(map[string]interface{})
+		newSpecsRaw := newSpecs.(map[string]interface{})
+
+		// Delete the removed specs.
+		removedKeys := blockStorageV3RemovedKeys(oldSpecsRaw, newSpecsRaw)
+		if len(removedKeys) > 0 {
+			deleteKeysOpts := qos.DeleteKeysOpts(removedKeys)
+			if err := qos.DeleteKeys(blockStorageClient, d.Id(), deleteKeysOpts).ExtractErr(); err != nil {
+				return fmt.Errorf("Error deleting specs %v from OpenStack QoS specification %s: %s", removedKeys, d.Id(), err)
+			}
+		}
+
+		// Create or update the remaining specs.
+		if len(newSpecsRaw) > 0 {
+			hasChange = true
+			updateOpts.Specs = expandBlockStorageQoSV3Specs(newSpecsRaw)
+		}
+	}
+
+	if hasChange {
+		log.Printf("[DEBUG] Update Options: %#v", updateOpts)
+		_, err = qos.Update(blockStorageClient, d.Id(), updateOpts).Extract()
+		if err != nil {
+			return fmt.Errorf("Error updating OpenStack QoS specification %s: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("volume_type_ids") {
+		oldTypes, newTypes := d.GetChange("volume_type_ids")
+		oldTypesSet := oldTypes.(*schema.Set)
+		newTypesSet := newTypes.(*schema.Set)
+
+		for _, volumeTypeID := range oldTypesSet.Difference(newTypesSet).List() {
+			disassociateOpts := qos.DisassociateOpts{
+				VolumeTypeID: volumeTypeID.(string),
+			}
+			if err := qos.Disassociate(blockStorageClient, d.Id(), disassociateOpts).ExtractErr(); err != nil {
+				return fmt.Errorf("Error disassociating OpenStack QoS specification %s from volume type %s: %s", d.Id(), volumeTypeID, err)
+			}
+		}
+
+		for _, volumeTypeID := range newTypesSet.Difference(oldTypesSet).List() {
+			associateOpts := qos.AssociateOpts{
+				VolumeTypeID: volumeTypeID.(string),
+			}
+			if err := qos.Associate(blockStorageClient, d.Id(), associateOpts).ExtractErr(); err != nil {
+				return fmt.Errorf("Error associating OpenStack QoS specification %s with volume type %s: %s", d.Id(), volumeTypeID, err)
+			}
+		}
+	}
+
+	return resourceBlockStorageQoSV3Read(d, meta)
+}
+
+func resourceBlockStorageQoSV3Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	blockStorageClient, err := config.blockStorageV3Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack block storage client: %s", err)
+	}
+
+	// A QoS specification can't be deleted while it is in use.
+	for _, volumeTypeID := range d.Get("volume_type_ids").(*schema.Set).List() {
+		disassociateOpts := qos.DisassociateOpts{
+			VolumeTypeID: volumeTypeID.(string),
+		}
+		if err := qos.Disassociate(blockStorageClient, d.Id(), disassociateOpts).ExtractErr(); err != nil {
+			return CheckDeleted(d, err, "QoS specification")
+		}
+	}
+
+	if err := qos.Delete(blockStorageClient, d.Id(), qos.DeleteOpts{}).ExtractErr(); err != nil {
+		return CheckDeleted(d, err, "QoS specification")
+	}
+
+	d.SetId("")
+	return nil
+}
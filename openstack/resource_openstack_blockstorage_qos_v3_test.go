@@ -0,0 +1,151 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+
+	"github.com/samuelbernardolip/gophercloud/openstack/blockstorage/v3/qos"
+)
+
+func TestAccBlockStorageV3QoS_basic(t *testing.T) {
+	var qosSpec qos.QoS
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckBlockStorageV3QoSDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccBlockStorageV3QoS_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBlockStorageV3QoSExists("openstack_blockstorage_qos_v3.qos_1", &qosSpec),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_qos_v3.qos_1", "name", "qos_1"),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_qos_v3.qos_1", "consumer", "front-end"),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_qos_v3.qos_1", "specs.%", "2"),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_qos_v3.qos_1", "specs.read_iops_sec", "20000"),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_qos_v3.qos_1", "volume_type_ids.#", "1"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccBlockStorageV3QoS_update,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBlockStorageV3QoSExists("openstack_blockstorage_qos_v3.qos_1", &qosSpec),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_qos_v3.qos_1", "consumer", "both"),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_qos_v3.qos_1", "specs.%", "1"),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_qos_v3.qos_1", "specs.read_iops_sec", "40000"),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_qos_v3.qos_1", "volume_type_ids.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckBlockStorageV3QoSDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	blockStorageClient, err := config.blockStorageV3Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack block storage client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "openstack_blockstorage_qos_v3" {
+			continue
+		}
+
+		_, err := qos.Get(blockStorageClient, rs.Primary.ID).Extract()
+		if err == nil {
+			return fmt.Errorf("QoS specification still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckBlockStorageV3QoSExists(n string, qosSpec *qos.QoS) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		blockStorageClient, err := config.blockStorageV3Client(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack block storage client: %s", err)
+		}
+
+		found, err := qos.Get(blockStorageClient, rs.Primary.ID).Extract()
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return fmt.Errorf("QoS specification not found")
+		}
+
+		*qosSpec = *found
+
+		return nil
+	}
+}
+
+const testAccBlockStorageV3QoS_basic = `
+resource "openstack_blockstorage_volume_type_v3" "volume_type_1" {
+  name = "volume_type_1"
+}
+
+resource "openstack_blockstorage_volume_type_v3" "volume_type_2" {
+  name = "volume_type_2"
+}
+
+resource "openstack_blockstorage_qos_v3" "qos_1" {
+  name = "qos_1"
+  consumer = "front-end"
+  specs {
+    read_iops_sec = "20000"
+    write_iops_sec = "10000"
+  }
+  volume_type_ids = ["${openstack_blockstorage_volume_type_v3.volume_type_1.id}"]
+}
+`
+
+const testAccBlockStorageV3QoS_update = `
+resource "openstack_blockstorage_volume_type_v3" "volume_type_1" {
+  name = "volume_type_1"
+}
+
+resource "openstack_blockstorage_volume_type_v3" "volume_type_2" {
+  name = "volume_type_2"
+}
+
+resource "openstack_blockstorage_qos_v3" "qos_1" {
+  name = "qos_1"
+  consumer = "both"
+  specs {
+    read_iops_sec = "40000"
+  }
+  volume_type_ids = [
+    "${openstack_blockstorage_volume_type_v3.volume_type_1.id}",
+    "${openstack_blockstorage_volume_type_v3.volume_type_2.id}",
+  ]
+}
+`
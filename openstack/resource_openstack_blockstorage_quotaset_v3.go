@@ -0,0 +1,177 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+
+	"github.com/samuelbernardolip/gophercloud/openstack/blockstorage/extensions/quotasets"
+)
+
+func resourceBlockStorageQuotasetV3() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBlockStorageQuotasetV3Create,
+		Read:   resourceBlockStorageQuotasetV3Read,
+		Update: resourceBlockStorageQuotasetV3Update,
+		Delete: resourceBlockStorageQuotasetV3Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"project_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"volumes": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+
+			"snapshots": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+
+			"gigabytes": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+
+			"per_volume_gigabytes": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+
+			"backups": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+
+			"backup_gigabytes": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+
+			"groups": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceBlockStorageQuotasetV3Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	blockStorageClient, err := config.blockStorageV3Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack block storage client: %s", err)
+	}
+
+	projectID := d.Get("project_id").(string)
+	updateOpts := quotasets.UpdateOpts{
+		Volumes:            quotaValue(d, "volumes"),
+		Snapshots:          quotaValue(d, "snapshots"),
+		Gigabytes:          quotaValue(d, "gigabytes"),
+		PerVolumeGigabytes: quotaValue(d, "per_volume_gigabytes"),
+		Backups:            quotaValue(d, "backups"),
+		BackupGigabytes:    quotaValue(d, "backup_gigabytes"),
+		Groups:             quotaValue(d, "groups"),
+	}
+
+	log.Printf("[DEBUG] openstack_blockstorage_quotaset_v3 create options: %#v", updateOpts)
+	_, err = quotasets.Update(blockStorageClient, projectID, updateOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error creating openstack_blockstorage_quotaset_v3 for project %s: %s", projectID, err)
+	}
+
+	d.SetId(projectID)
+
+	return resourceBlockStorageQuotasetV3Read(d, meta)
+}
+
+func resourceBlockStorageQuotasetV3Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	blockStorageClient, err := config.blockStorageV3Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack block storage client: %s", err)
+	}
+
+	q, err := quotasets.Get(blockStorageClient, d.Id()).Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "openstack_blockstorage_quotaset_v3")
+	}
+
+	log.Printf("[DEBUG] Retrieved openstack_blockstorage_quotaset_v3 %s: %#v", d.Id(), q)
+
+	d.Set("project_id", d.Id())
+	d.Set("region", GetRegion(d, config))
+	d.Set("volumes", q.Volumes)
+	d.Set("snapshots", q.Snapshots)
+	d.Set("gigabytes", q.Gigabytes)
+	d.Set("per_volume_gigabytes", q.PerVolumeGigabytes)
+	d.Set("backups", q.Backups)
+	d.Set("backup_gigabytes", q.BackupGigabytes)
+	d.Set("groups", q.Groups)
+
+	return nil
+}
+
+func resourceBlockStorageQuotasetV3Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	blockStorageClient, err := config.blockStorageV3Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack block storage client: %s", err)
+	}
+
+	updateOpts := quotasets.UpdateOpts{
+		Volumes:            quotaChangedValue(d, "volumes"),
+		Snapshots:          quotaChangedValue(d, "snapshots"),
+		Gigabytes:          quotaChangedValue(d, "gigabytes"),
+		PerVolumeGigabytes: quotaChangedValue(d, "per_volume_gigabytes"),
+		Backups:            quotaChangedValue(d, "backups"),
+		BackupGigabytes:    quotaChangedValue(d, "backup_gigabytes"),
+		Groups:             quotaChangedValue(d, "groups"),
+	}
+
+	log.Printf("[DEBUG] openstack_blockstorage_quotaset_v3 %s update options: %#v", d.Id(), updateOpts)
+	_, err = quotasets.Update(blockStorageClient, d.Id(), updateOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error updating openstack_blockstorage_quotaset_v3 %s: %s", d.Id(), err)
+	}
+
+	return resourceBlockStorageQuotasetV3Read(d, meta)
+}
+
+func resourceBlockStorageQuotasetV3Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	blockStorageClient, err := config.blockStorageV3Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack block storage client: %s", err)
+	}
+
+	log.Printf("[DEBUG] Restoring default quotas for openstack_blockstorage_quotaset_v3 %s", d.Id())
+	err = quotasets.Delete(blockStorageClient, d.Id()).ExtractErr()
+	if err != nil {
+		return CheckDeleted(d, err, "Error restoring default quotas for openstack_blockstorage_quotaset_v3")
+	}
+
+	return nil
+}
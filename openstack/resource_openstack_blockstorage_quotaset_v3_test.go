@@ -0,0 +1,85 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccBlockStorageV3Quotaset_basic(t *testing.T) {
+	projectName := fmt.Sprintf("tf_test_%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIdentityV3ProjectDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccBlockStorageV3Quotaset_basic(projectName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"openstack_blockstorage_quotaset_v3.quotaset_1", "project_id",
+						"openstack_identity_project_v3.project_1", "id"),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_quotaset_v3.quotaset_1", "volumes", "5"),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_quotaset_v3.quotaset_1", "snapshots", "5"),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_quotaset_v3.quotaset_1", "gigabytes", "100"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccBlockStorageV3Quotaset_update(projectName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"openstack_blockstorage_quotaset_v3.quotaset_1", "project_id",
+						"openstack_identity_project_v3.project_1", "id"),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_quotaset_v3.quotaset_1", "volumes", "10"),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_quotaset_v3.quotaset_1", "snapshots", "10"),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_quotaset_v3.quotaset_1", "gigabytes", "200"),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_quotaset_v3.quotaset_1", "backups", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccBlockStorageV3Quotaset_basic(projectName string) string {
+	return fmt.Sprintf(`
+resource "openstack_identity_project_v3" "project_1" {
+  name = "%s"
+}
+
+resource "openstack_blockstorage_quotaset_v3" "quotaset_1" {
+  project_id = "${openstack_identity_project_v3.project_1.id}"
+  volumes    = 5
+  snapshots  = 5
+  gigabytes  = 100
+}
+`, projectName)
+}
+
+func testAccBlockStorageV3Quotaset_update(projectName string) string {
+	return fmt.Sprintf(`
+resource "openstack_identity_project_v3" "project_1" {
+  name = "%s"
+}
+
+resource "openstack_blockstorage_quotaset_v3" "quotaset_1" {
+  project_id = "${openstack_identity_project_v3.project_1.id}"
+  volumes    = 10
+  snapshots  = 10
+  gigabytes  = 200
+  backups    = 2
+}
+`, projectName)
+}
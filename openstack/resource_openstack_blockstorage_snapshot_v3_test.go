@@ -0,0 +1,195 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+
+	"github.com/samuelbernardolip/gophercloud/openstack/blockstorage/v3/snapshots"
+)
+
+func TestAccBlockStorageV3Snapshot_basic(t *testing.T) {
+	var snapshot snapshots.Snapshot
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckBlockStorageV3SnapshotDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccBlockStorageV3Snapshot_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBlockStorageV3SnapshotExists("openstack_blockstorage_snapshot_v3.snapshot_1", &snapshot),
+					testAccCheckBlockStorageV3SnapshotMetadata(&snapshot, "foo", "bar"),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_snapshot_v3.snapshot_1", "name", "snapshot_1"),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_snapshot_v3.snapshot_1", "description", "first test snapshot"),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_snapshot_v3.snapshot_1", "size", "1"),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_snapshot_v3.snapshot_1", "status", "available"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccBlockStorageV3Snapshot_update,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBlockStorageV3SnapshotExists("openstack_blockstorage_snapshot_v3.snapshot_1", &snapshot),
+					testAccCheckBlockStorageV3SnapshotMetadata(&snapshot, "foo", "baz"),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_snapshot_v3.snapshot_1", "name", "snapshot_1-updated"),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_snapshot_v3.snapshot_1", "description", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBlockStorageV3Snapshot_volumeFromSnapshot(t *testing.T) {
+	var snapshot snapshots.Snapshot
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckBlockStorageV3SnapshotDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccBlockStorageV3Snapshot_volumeFromSnapshot,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBlockStorageV3SnapshotExists("openstack_blockstorage_snapshot_v3.snapshot_1", &snapshot),
+					resource.TestCheckResourceAttrPair(
+						"openstack_blockstorage_volume_v3.volume_2", "snapshot_id",
+						"openstack_blockstorage_snapshot_v3.snapshot_1", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckBlockStorageV3SnapshotDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	blockStorageClient, err := config.blockStorageV3Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack block storage client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "openstack_blockstorage_snapshot_v3" {
+			continue
+		}
+
+		_, err := snapshots.Get(blockStorageClient, rs.Primary.ID).Extract()
+		if err == nil {
+			return fmt.Errorf("Snapshot still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckBlockStorageV3SnapshotExists(n string, snapshot *snapshots.Snapshot) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		blockStorageClient, err := config.blockStorageV3Client(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack block storage client: %s", err)
+		}
+
+		found, err := snapshots.Get(blockStorageClient, rs.Primary.ID).Extract()
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return fmt.Errorf("Snapshot not found")
+		}
+
+		*snapshot = *found
+
+		return nil
+	}
+}
+
+func testAccCheckBlockStorageV3SnapshotMetadata(
+	snapshot *snapshots.Snapshot, k string, v string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if snapshot.Metadata == nil {
+			return fmt.Errorf("No metadata")
+		}
+
+		for key, value := range snapshot.Metadata {
+			if k != key {
+				continue
+			}
+
+			if v == value {
+				return nil
+			}
+
+			return fmt.Errorf("Bad value for %s: %s", k, value)
+		}
+
+		return fmt.Errorf("Metadata not found: %s", k)
+	}
+}
+
+const testAccBlockStorageV3Snapshot_basic = `
+resource "openstack_blockstorage_volume_v3" "volume_1" {
+  name = "volume_1"
+  size = 1
+}
+
+resource "openstack_blockstorage_snapshot_v3" "snapshot_1" {
+  name = "snapshot_1"
+  description = "first test snapshot"
+  volume_id = "${openstack_blockstorage_volume_v3.volume_1.id}"
+  metadata {
+    foo = "bar"
+  }
+}
+`
+
+const testAccBlockStorageV3Snapshot_update = `
+resource "openstack_blockstorage_volume_v3" "volume_1" {
+  name = "volume_1"
+  size = 1
+}
+
+resource "openstack_blockstorage_snapshot_v3" "snapshot_1" {
+  name = "snapshot_1-updated"
+  volume_id = "${openstack_blockstorage_volume_v3.volume_1.id}"
+  metadata {
+    foo = "baz"
+  }
+}
+`
+
+const testAccBlockStorageV3Snapshot_volumeFromSnapshot = `
+resource "openstack_blockstorage_volume_v3" "volume_1" {
+  name = "volume_1"
+  size = 1
+}
+
+resource "openstack_blockstorage_snapshot_v3" "snapshot_1" {
+  name = "snapshot_1"
+  volume_id = "${openstack_blockstorage_volume_v3.volume_1.id}"
+}
+
+resource "openstack_blockstorage_volume_v3" "volume_2" {
+  name = "volume_2"
+  size = 1
+  snapshot_id = "${openstack_blockstorage_snapshot_v3.snapshot_1.id}"
+}
+`
@@ -0,0 +1,184 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/samuelbernardolip/gophercloud/openstack/blockstorage/v3/volumetypes"
+)
+
+func resourceBlockStorageVolumeTypeEncryptionV3() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBlockStorageVolumeTypeEncryptionV3Create,
+		Read:   resourceBlockStorageVolumeTypeEncryptionV3Read,
+		Update: resourceBlockStorageVolumeTypeEncryptionV3Update,
+		Delete: resourceBlockStorageVolumeTypeEncryptionV3Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"volume_type_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"encryption_provider": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: false,
+			},
+
+			"control_location": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: false,
+				Default:  "front-end",
+				ValidateFunc: validation.StringInSlice([]string{
+					"front-end", "back-end",
+				}, false),
+			},
+
+			"cipher": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: false,
+				Computed: true,
+			},
+
+			"key_size": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				ForceNew: false,
+				Computed: true,
+			},
+
+			"encryption_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceBlockStorageVolumeTypeEncryptionV3Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	blockStorageClient, err := config.blockStorageV3Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack block storage client: %s", err)
+	}
+
+	volumeTypeID := d.Get("volume_type_id").(string)
+
+	createOpts := &volumetypes.CreateEncryptionOpts{
+		Provider:        d.Get("encryption_provider").(string),
+		ControlLocation: d.Get("control_location").(string),
+		Cipher:          d.Get("cipher").(string),
+		KeySize:         d.Get("key_size").(int),
+	}
+
+	log.Printf("[DEBUG] Create Options: %#v", createOpts)
+	e, err := volumetypes.CreateEncryption(blockStorageClient, volumeTypeID, createOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack volume type %s encryption: %s", volumeTypeID, err)
+	}
+	log.Printf("[INFO] Volume type %s encryption ID: %s", volumeTypeID, e.EncryptionID)
+
+	// A volume type has at most one encryption, so it is identified by the
+	// volume type ID.
+	d.SetId(volumeTypeID)
+
+	return resourceBlockStorageVolumeTypeEncryptionV3Read(d, meta)
+}
+
+func resourceBlockStorageVolumeTypeEncryptionV3Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	blockStorageClient, err := config.blockStorageV3Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack block storage client: %s", err)
+	}
+
+	e, err := volumetypes.GetEncryption(blockStorageClient, d.Id()).Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "volume type encryption")
+	}
+
+	// An empty encryption is returned when the volume type is not encrypted.
+	if e.EncryptionID == "" {
+		log.Printf("[DEBUG] Volume type %s is not encrypted", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	log.Printf("[DEBUG] Retrieved volume type %s encryption: %+v", d.Id(), e)
+
+	d.Set("volume_type_id", d.Id())
+	d.Set("encryption_provider", e.Provider)
+	d.Set("control_location", e.ControlLocation)
+	d.Set("cipher", e.Cipher)
+	d.Set("key_size", e.KeySize)
+	d.Set("encryption_id", e.EncryptionID)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceBlockStorageVolumeTypeEncryptionV3Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	blockStorageClient, err := config.blockStorageV3Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack block storage client: %s", err)
+	}
+
+	var updateOpts volumetypes.UpdateEncryptionOpts
+
+	if d.HasChange("encryption_provider") {
+		updateOpts.Provider = d.Get("encryption_provider").(string)
+	}
+
+	if d.HasChange("control_location") {
+		updateOpts.ControlLocation = d.Get("control_location").(string)
+	}
+
+	if d.HasChange("cipher") {
+		updateOpts.Cipher = d.Get("cipher").(string)
+	}
+
+	if d.HasChange("key_size") {
+		updateOpts.KeySize = d.Get("key_size").(int)
+	}
+
+	log.Printf("[DEBUG] Update Options: %#v", updateOpts)
+	_, err = volumetypes.UpdateEncryption(blockStorageClient, d.Id(), d.Get("encryption_id").(string), updateOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error updating OpenStack volume type %s encryption: %s", d.Id(), err)
+	}
+
+	return resourceBlockStorageVolumeTypeEncryptionV3Read(d, meta)
+}
+
+func resourceBlockStorageVolumeTypeEncryptionV3Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	blockStorageClient, err := config.blockStorageV3Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack block storage client: %s", err)
+	}
+
+	err = volumetypes.DeleteEncryption(blockStorageClient, d.Id(), d.Get("encryption_id").(string)).ExtractErr()
+	if err != nil {
+		return CheckDeleted(d, err, "volume type encryption")
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,130 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+
+	"github.com/samuelbernardolip/gophercloud/openstack/blockstorage/v3/volumetypes"
+)
+
+func TestAccBlockStorageV3VolumeTypeEncryption_basic(t *testing.T) {
+	var encryption volumetypes.EncryptionType
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckBlockStorageV3VolumeTypeEncryptionDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccBlockStorageV3VolumeTypeEncryption_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBlockStorageV3VolumeTypeEncryptionExists("openstack_blockstorage_volume_type_encryption_v3.encryption_1", &encryption),
+					resource.TestCheckResourceAttrPair(
+						"openstack_blockstorage_volume_type_encryption_v3.encryption_1", "volume_type_id",
+						"openstack_blockstorage_volume_type_v3.volume_type_1", "id"),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_volume_type_encryption_v3.encryption_1", "encryption_provider", "luks"),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_volume_type_encryption_v3.encryption_1", "control_location", "front-end"),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_volume_type_encryption_v3.encryption_1", "cipher", "aes-xts-plain64"),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_volume_type_encryption_v3.encryption_1", "key_size", "256"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccBlockStorageV3VolumeTypeEncryption_update,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBlockStorageV3VolumeTypeEncryptionExists("openstack_blockstorage_volume_type_encryption_v3.encryption_1", &encryption),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_volume_type_encryption_v3.encryption_1", "key_size", "512"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckBlockStorageV3VolumeTypeEncryptionDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	blockStorageClient, err := config.blockStorageV3Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack block storage client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "openstack_blockstorage_volume_type_encryption_v3" {
+			continue
+		}
+
+		e, err := volumetypes.GetEncryption(blockStorageClient, rs.Primary.ID).Extract()
+		if err == nil && e.EncryptionID != "" {
+			return fmt.Errorf("Volume type encryption still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckBlockStorageV3VolumeTypeEncryptionExists(n string, encryption *volumetypes.EncryptionType) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		blockStorageClient, err := config.blockStorageV3Client(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack block storage client: %s", err)
+		}
+
+		found, err := volumetypes.GetEncryption(blockStorageClient, rs.Primary.ID).Extract()
+		if err != nil {
+			return err
+		}
+
+		if found.EncryptionID == "" || found.EncryptionID != rs.Primary.Attributes["encryption_id"] {
+			return fmt.Errorf("Volume type encryption not found")
+		}
+
+		*encryption = *found
+
+		return nil
+	}
+}
+
+const testAccBlockStorageV3VolumeTypeEncryption_basic = `
+resource "openstack_blockstorage_volume_type_v3" "volume_type_1" {
+  name = "volume_type_1"
+}
+
+resource "openstack_blockstorage_volume_type_encryption_v3" "encryption_1" {
+  volume_type_id = "${openstack_blockstorage_volume_type_v3.volume_type_1.id}"
+  encryption_provider = "luks"
+  cipher = "aes-xts-plain64"
+  key_size = 256
+}
+`
+
+const testAccBlockStorageV3VolumeTypeEncryption_update = `
+resource "openstack_blockstorage_volume_type_v3" "volume_type_1" {
+  name = "volume_type_1"
+}
+
+resource "openstack_blockstorage_volume_type_encryption_v3" "encryption_1" {
+  volume_type_id = "${openstack_blockstorage_volume_type_v3.volume_type_1.id}"
+  encryption_provider = "luks"
+  cipher = "aes-xts-plain64"
+  key_size = 512
+}
+`
@@ -0,0 +1,257 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/samuelbernardolip/gophercloud/openstack/blockstorage/v3/volumetypes"
+)
+
+func resourceBlockStorageVolumeTypeV3() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBlockStorageVolumeTypeV3Create,
+		Read:   resourceBlockStorageVolumeTypeV3Read,
+		Update: resourceBlockStorageVolumeTypeV3Update,
+		Delete: resourceBlockStorageVolumeTypeV3Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: false,
+			},
+
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: false,
+			},
+
+			"is_public": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: false,
+				Default:  true,
+			},
+
+			"extra_specs": &schema.Schema{
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: false,
+				Computed: true,
+			},
+
+			"project_ids": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				ForceNew: false,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+		},
+	}
+}
+
+func resourceBlockStorageVolumeTypeV3Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	blockStorageClient, err := config.blockStorageV3Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack block storage client: %s", err)
+	}
+
+	isPublic := d.Get("is_public").(bool)
+	projectIDs := d.Get("project_ids").(*schema.Set)
+	if isPublic && projectIDs.Len() > 0 {
+		return fmt.Errorf("project_ids can only be set on volume types with is_public set to false")
+	}
+
+	createOpts := &volumetypes.CreateOpts{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+		IsPublic:    &isPublic,
+		ExtraSpecs:  expandBlockStorageVolumeTypeV3ExtraSpecs(d.Get("extra_specs").(map[string]interface{})),
+	}
+
+	log.Printf("[DEBUG] Create Options: %#v", createOpts)
+	vt, err := volumetypes.Create(blockStorageClient, createOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack volume type: %s", err)
+	}
+	log.Printf("[INFO] Volume type ID: %s", vt.ID)
+
+	// Store the ID now
+	d.SetId(vt.ID)
+
+	for _, projectID := range projectIDs.List() {
+		accessOpts := volumetypes.AddAccessOpts{
+			Project: projectID.(string),
+		}
+		if err := volumetypes.AddAccess(blockStorageClient, vt.ID, accessOpts).ExtractErr(); err != nil {
+			return fmt.Errorf("Error adding project %s access to OpenStack volume type %s: %s", projectID, vt.ID, err)
+		}
+	}
+
+	return resourceBlockStorageVolumeTypeV3Read(d, meta)
+}
+
+func resourceBlockStorageVolumeTypeV3Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	blockStorageClient, err := config.blockStorageV3Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack block storage client: %s", err)
+	}
+
+	vt, err := volumetypes.Get(blockStorageClient, d.Id()).Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "volume type")
+	}
+
+	log.Printf("[DEBUG] Retrieved volume type %s: %+v", d.Id(), vt)
+
+	d.Set("name", vt.Name)
+	d.Set("description", vt.Description)
+	d.Set("is_public", vt.IsPublic)
+	d.Set("region", GetRegion(d, config))
+
+	if err := d.Set("extra_specs", vt.ExtraSpecs); err != nil {
+		log.Printf("[WARN] Unable to set extra_specs for volume type %s: %s", d.Id(), err)
+	}
+
+	// The access list is only available for private volume types.
+	var projectIDs []string
+	if !vt.IsPublic {
+		allPages, err := volumetypes.ListAccesses(blockStorageClient, d.Id()).AllPages()
+		if err != nil {
+			return fmt.Errorf("Error retrieving access list of OpenStack volume type %s: %s", d.Id(), err)
+		}
+
+		accesses, err := volumetypes.ExtractAccesses(allPages)
+		if err != nil {
+			return fmt.Errorf("Error extracting access list of OpenStack volume type %s: %s", d.Id(), err)
+		}
+
+		projectIDs = flattenBlockStorageVolumeTypeV3Accesses(accesses)
+	}
+
+	if err := d.Set("project_ids", projectIDs); err != nil {
+		log.Printf("[WARN] Unable to set project_ids for volume type %s: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceBlockStorageVolumeTypeV3Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	blockStorageClient, err := config.blockStorageV3Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack block storage client: %s", err)
+	}
+
+	isPublic := d.Get("is_public").(bool)
+	if isPublic && d.Get("project_ids").(*schema.Set).Len() > 0 {
+		return fmt.Errorf("project_ids can only be set on volume types with is_public set to false")
+	}
+
+	var hasChange bool
+	var updateOpts volumetypes.UpdateOpts
+
+	if d.HasChange("name") {
+		hasChange = true
+		name := d.Get("name").(string)
+		updateOpts.Name = &name
+	}
+
+	if d.HasChange("description") {
+		hasChange = true
+		description := d.Get("description").(string)
+		updateOpts.Description = &description
+	}
+
+	if d.HasChange("is_public") {
+		hasChange = true
+		updateOpts.IsPublic = &isPublic
+	}
+
+	if hasChange {
+		log.Printf("[DEBUG] Update Options: %#v", updateOpts)
+		_, err = volumetypes.Update(blockStorageClient, d.Id(), updateOpts).Extract()
+		if err != nil {
+			return fmt.Errorf("Error updating OpenStack volume type %s: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("extra_specs") {
+		oldES, newES := d.GetChange("extra_specs")
+		oldESRaw := oldES.(map[string]interface{})
+		newESRaw := newES.(map[string]interface{})
+
+		// Delete the removed extra specs.
+		for _, key := range blockStorageV3RemovedKeys(oldESRaw, newESRaw) {
+			if err := volumetypes.DeleteExtraSpec(blockStorageClient, d.Id(), key).ExtractErr(); err != nil {
+				return fmt.Errorf("Error deleting extra_spec %s from OpenStack volume type %s: %s", key, d.Id(), err)
+			}
+		}
+
+		// Create or update the remaining extra specs.
+		if len(newESRaw) > 0 {
+			extraSpecs := expandBlockStorageVolumeTypeV3ExtraSpecs(newESRaw)
+
+			_, err := volumetypes.CreateExtraSpecs(blockStorageClient, d.Id(), extraSpecs).Extract()
+			if err != nil {
+				return fmt.Errorf("Error creating extra_specs for OpenStack volume type %s: %s", d.Id(), err)
+			}
+		}
+	}
+
+	if d.HasChange("project_ids") {
+		oldProjects, newProjects := d.GetChange("project_ids")
+		oldProjectsSet := oldProjects.(*schema.Set)
+		newProjectsSet := newProjects.(*schema.Set)
+
+		for _, projectID := range oldProjectsSet.Difference(newProjectsSet).List() {
+			accessOpts := volumetypes.RemoveAccessOpts{
+				Project: projectID.(string),
+			}
+			if err := volumetypes.RemoveAccess(blockStorageClient, d.Id(), accessOpts).ExtractErr(); err != nil {
+				return fmt.Errorf("Error removing project %s access to OpenStack volume type %s: %s", projectID, d.Id(), err)
+			}
+		}
+
+		for _, projectID := range newProjectsSet.Difference(oldProjectsSet).List() {
+			accessOpts := volumetypes.AddAccessOpts{
+				Project: projectID.(string),
+			}
+			if err := volumetypes.AddAccess(blockStorageClient, d.Id(), accessOpts).ExtractErr(); err != nil {
+				return fmt.Errorf("Error adding project %s access to OpenStack volume type %s: %s", projectID, d.Id(), err)
+			}
+		}
+	}
+
+	return resourceBlockStorageVolumeTypeV3Read(d, meta)
+}
+
+func resourceBlockStorageVolumeTypeV3Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	blockStorageClient, err := config.blockStorageV3Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack block storage client: %s", err)
+	}
+
+	if err := volumetypes.Delete(blockStorageClient, d.Id()).ExtractErr(); err != nil {
+		return CheckDeleted(d, err, "volume type")
+	}
+
+	d.SetId("")
+	return nil
+}
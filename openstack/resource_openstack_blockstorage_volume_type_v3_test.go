@@ -0,0 +1,197 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+
+	"github.com/samuelbernardolip/gophercloud/openstack/blockstorage/v3/volumetypes"
+)
+
+func TestAccBlockStorageV3VolumeType_basic(t *testing.T) {
+	var volumeType volumetypes.VolumeType
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckBlockStorageV3VolumeTypeDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccBlockStorageV3VolumeType_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBlockStorageV3VolumeTypeExists("openstack_blockstorage_volume_type_v3.volume_type_1", &volumeType),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_volume_type_v3.volume_type_1", "name", "volume_type_1"),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_volume_type_v3.volume_type_1", "description", "first test volume type"),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_volume_type_v3.volume_type_1", "is_public", "true"),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_volume_type_v3.volume_type_1", "extra_specs.%", "2"),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_volume_type_v3.volume_type_1", "extra_specs.capabilities", "gpu"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccBlockStorageV3VolumeType_update,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBlockStorageV3VolumeTypeExists("openstack_blockstorage_volume_type_v3.volume_type_1", &volumeType),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_volume_type_v3.volume_type_1", "name", "volume_type_1-updated"),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_volume_type_v3.volume_type_1", "description", ""),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_volume_type_v3.volume_type_1", "extra_specs.%", "1"),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_volume_type_v3.volume_type_1", "extra_specs.capabilities", "ssd"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBlockStorageV3VolumeType_private(t *testing.T) {
+	var volumeType volumetypes.VolumeType
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckBlockStorageV3VolumeTypeDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccBlockStorageV3VolumeType_private,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBlockStorageV3VolumeTypeExists("openstack_blockstorage_volume_type_v3.volume_type_1", &volumeType),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_volume_type_v3.volume_type_1", "is_public", "false"),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_volume_type_v3.volume_type_1", "project_ids.#", "1"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccBlockStorageV3VolumeType_privateUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBlockStorageV3VolumeTypeExists("openstack_blockstorage_volume_type_v3.volume_type_1", &volumeType),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_volume_type_v3.volume_type_1", "project_ids.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckBlockStorageV3VolumeTypeDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	blockStorageClient, err := config.blockStorageV3Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack block storage client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "openstack_blockstorage_volume_type_v3" {
+			continue
+		}
+
+		_, err := volumetypes.Get(blockStorageClient, rs.Primary.ID).Extract()
+		if err == nil {
+			return fmt.Errorf("Volume type still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckBlockStorageV3VolumeTypeExists(n string, volumeType *volumetypes.VolumeType) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		blockStorageClient, err := config.blockStorageV3Client(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack block storage client: %s", err)
+		}
+
+		found, err := volumetypes.Get(blockStorageClient, rs.Primary.ID).Extract()
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return fmt.Errorf("Volume type not found")
+		}
+
+		*volumeType = *found
+
+		return nil
+	}
+}
+
+const testAccBlockStorageV3VolumeType_basic = `
+resource "openstack_blockstorage_volume_type_v3" "volume_type_1" {
+  name = "volume_type_1"
+  description = "first test volume type"
+  extra_specs {
+    capabilities = "gpu"
+    volume_backend_name = "lvmdriver-1"
+  }
+}
+`
+
+const testAccBlockStorageV3VolumeType_update = `
+resource "openstack_blockstorage_volume_type_v3" "volume_type_1" {
+  name = "volume_type_1-updated"
+  extra_specs {
+    capabilities = "ssd"
+  }
+}
+`
+
+const testAccBlockStorageV3VolumeType_private = `
+resource "openstack_identity_project_v3" "project_1" {
+  name = "project_1"
+}
+
+resource "openstack_identity_project_v3" "project_2" {
+  name = "project_2"
+}
+
+resource "openstack_blockstorage_volume_type_v3" "volume_type_1" {
+  name = "volume_type_1"
+  is_public = false
+  project_ids = ["${openstack_identity_project_v3.project_1.id}"]
+}
+`
+
+const testAccBlockStorageV3VolumeType_privateUpdate = `
+resource "openstack_identity_project_v3" "project_1" {
+  name = "project_1"
+}
+
+resource "openstack_identity_project_v3" "project_2" {
+  name = "project_2"
+}
+
+resource "openstack_blockstorage_volume_type_v3" "volume_type_1" {
+  name = "volume_type_1"
+  is_public = false
+  project_ids = [
+    "${openstack_identity_project_v3.project_1.id}",
+    "${openstack_identity_project_v3.project_2.id}",
+  ]
+}
+`
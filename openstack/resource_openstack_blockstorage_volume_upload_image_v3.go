@@ -0,0 +1,292 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/samuelbernardolip/gophercloud"
+	"github.com/samuelbernardolip/gophercloud/openstack/blockstorage/extensions/volumeactions"
+	"github.com/samuelbernardolip/gophercloud/openstack/imageservice/v2/images"
+)
+
+// blockStorageVolumeUploadImageV3Microversion is the first block storage API
+// microversion that allows the visibility and protection of an uploaded image
+// to be set.
+const blockStorageVolumeUploadImageV3Microversion = "3.1"
+
+// blockStorageVolumeUploadImageV3Opts is a custom UploadImageOpts struct to
+// set the visibility and protection of the uploaded image.
+type blockStorageVolumeUploadImageV3Opts struct {
+	volumeactions.UploadImageOpts
+
+	// Visibility defines who can see and use the image.
+	Visibility string `json:"visibility,omitempty"`
+
+	// Protected prevents the image from being deleted.
+	Protected bool `json:"protected,omitempty"`
+}
+
+// ToVolumeUploadImageMap casts an UploadImageOpts struct to a map.
+// It overrides volumeactions.ToVolumeUploadImageMap to add the Visibility and
+// Protected fields.
+func (opts blockStorageVolumeUploadImageV3Opts) ToVolumeUploadImageMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "os-volume_upload_image")
+}
+
+// blockStorageVolumeUploadImageV3ReplaceProtected represents an update of
+// the protected property of an image.
+type blockStorageVolumeUploadImageV3ReplaceProtected struct {
+	NewProtected bool
+}
+
+// ToImagePatchMap assembles a request body based on
+// blockStorageVolumeUploadImageV3ReplaceProtected.
+func (r blockStorageVolumeUploadImageV3ReplaceProtected) ToImagePatchMap() map[string]interface{} {
+	return map[string]interface{}{
+		"op":    "replace",
+		"path":  "/protected",
+		"value": r.NewProtected,
+	}
+}
+
+func resourceBlockStorageVolumeUploadImageV3() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBlockStorageVolumeUploadImageV3Create,
+		Read:   resourceBlockStorageVolumeUploadImageV3Read,
+		Delete: resourceBlockStorageVolumeUploadImageV3Delete,
+		Importer: &schema.ResourceImporter{
+			State: resourceBlockStorageVolumeUploadImageV3Import,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"volume_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"image_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"disk_format": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "raw",
+				ValidateFunc: resourceImagesImageV2ValidateDiskFormat,
+			},
+
+			"container_format": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "bare",
+				ValidateFunc: resourceImagesImageV2ValidateContainerFormat,
+			},
+
+			"visibility": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: resourceImagesImageV2ValidateVisibility,
+			},
+
+			"protected": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"force": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"image_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"status": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"size_bytes": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"checksum": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceBlockStorageVolumeUploadImageV3Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	blockStorageClient, err := config.blockStorageV3Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack block storage client: %s", err)
+	}
+
+	imageClient, err := config.imageV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack image client: %s", err)
+	}
+
+	volumeID := d.Get("volume_id").(string)
+	uploadOpts := &blockStorageVolumeUploadImageV3Opts{
+		UploadImageOpts: volumeactions.UploadImageOpts{
+			ImageName:       d.Get("image_name").(string),
+			DiskFormat:      d.Get("disk_format").(string),
+			ContainerFormat: d.Get("container_format").(string),
+			Force:           d.Get("force").(bool),
+		},
+		Visibility: d.Get("visibility").(string),
+		Protected:  d.Get("protected").(bool),
+	}
+
+	if uploadOpts.Visibility != "" || uploadOpts.Protected {
+		blockStorageClient.Microversion = blockStorageVolumeUploadImageV3Microversion
+	}
+
+	log.Printf("[DEBUG] Upload Image Options: %#v", uploadOpts)
+	volumeImage, err := volumeactions.UploadImage(blockStorageClient, volumeID, uploadOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error uploading volume %s to an image: %s", volumeID, err)
+	}
+	log.Printf("[INFO] Volume %s image ID: %s", volumeID, volumeImage.ImageID)
+
+	// Store the ID now
+	d.SetId(volumeImage.ImageID)
+
+	// Wait for the volume to be uploaded. It returns to in-use if it was
+	// uploaded with force while attached.
+	log.Printf("[DEBUG] Waiting for volume (%s) to be uploaded", volumeID)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"uploading"},
+		Target:     []string{"available", "in-use"},
+		Refresh:    VolumeV3StateRefreshFunc(blockStorageClient, volumeID),
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		Delay:      10 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, err = stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf("Error waiting for volume (%s) to be uploaded: %s", volumeID, err)
+	}
+
+	log.Printf("[DEBUG] Waiting for image (%s) to become active", d.Id())
+
+	stateConf = &resource.StateChangeConf{
+		Pending: []string{
+			string(images.ImageStatusQueued),
+			string(images.ImageStatusSaving),
+		},
+		Target:     []string{string(images.ImageStatusActive)},
+		Refresh:    resourceImagesImageV2RefreshFunc(imageClient, d.Id()),
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		Delay:      10 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, err = stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf("Error waiting for image (%s) to become active: %s", d.Id(), err)
+	}
+
+	return resourceBlockStorageVolumeUploadImageV3Read(d, meta)
+}
+
+func resourceBlockStorageVolumeUploadImageV3Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	imageClient, err := config.imageV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack image client: %s", err)
+	}
+
+	img, err := images.Get(imageClient, d.Id()).Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "volume image")
+	}
+
+	log.Printf("[DEBUG] Retrieved volume image %s: %#v", d.Id(), img)
+
+	d.Set("image_id", img.ID)
+	d.Set("image_name", img.Name)
+	d.Set("disk_format", img.DiskFormat)
+	d.Set("container_format", img.ContainerFormat)
+	d.Set("visibility", img.Visibility)
+	d.Set("protected", img.Protected)
+	d.Set("status", img.Status)
+	d.Set("size_bytes", img.SizeBytes)
+	d.Set("checksum", img.Checksum)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceBlockStorageVolumeUploadImageV3Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	imageClient, err := config.imageV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack image client: %s", err)
+	}
+
+	// Glance refuses to delete a protected image.
+	if d.Get("protected").(bool) {
+		updateOpts := images.UpdateOpts{
+			blockStorageVolumeUploadImageV3ReplaceProtected{NewProtected: false},
+		}
+
+		log.Printf("[DEBUG] Unprotecting volume image %s", d.Id())
+		if _, err := images.Update(imageClient, d.Id(), updateOpts).Extract(); err != nil {
+			return CheckDeleted(d, err, "volume image")
+		}
+	}
+
+	log.Printf("[DEBUG] Deleting volume image %s", d.Id())
+	if err := images.Delete(imageClient, d.Id()).ExtractErr(); err != nil {
+		return CheckDeleted(d, err, "volume image")
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceBlockStorageVolumeUploadImageV3Import(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.SplitN(d.Id(), "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, fmt.Errorf("Invalid format specified for openstack_blockstorage_volume_upload_image_v3. Format must be <volume id>/<image id>")
+	}
+
+	d.SetId(parts[1])
+	d.Set("volume_id", parts[0])
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,111 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/samuelbernardolip/gophercloud/openstack/blockstorage/v3/volumes"
+	"github.com/samuelbernardolip/gophercloud/openstack/imageservice/v2/images"
+)
+
+func TestAccBlockStorageV3VolumeUploadImage_basic(t *testing.T) {
+	var volume volumes.Volume
+	var image images.Image
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckBlockStorageV3VolumeUploadImageDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccBlockStorageV3VolumeUploadImage_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBlockStorageV3VolumeExists("openstack_blockstorage_volume_v3.volume_1", &volume),
+					testAccCheckImagesImageV2Exists("openstack_blockstorage_volume_upload_image_v3.image_1", &image),
+					resource.TestCheckResourceAttrPtr(
+						"openstack_blockstorage_volume_upload_image_v3.image_1", "image_id", &image.ID),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_volume_upload_image_v3.image_1", "image_name", "image_1"),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_volume_upload_image_v3.image_1", "disk_format", "qcow2"),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_volume_upload_image_v3.image_1", "container_format", "bare"),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_volume_upload_image_v3.image_1", "visibility", "private"),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_volume_upload_image_v3.image_1", "status", "active"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBlockStorageV3VolumeUploadImage_protected(t *testing.T) {
+	var image images.Image
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckBlockStorageV3VolumeUploadImageDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccBlockStorageV3VolumeUploadImage_protected,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckImagesImageV2Exists("openstack_blockstorage_volume_upload_image_v3.image_1", &image),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_volume_upload_image_v3.image_1", "protected", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckBlockStorageV3VolumeUploadImageDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	imageClient, err := config.imageV2Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack image client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "openstack_blockstorage_volume_upload_image_v3" {
+			continue
+		}
+
+		_, err := images.Get(imageClient, rs.Primary.ID).Extract()
+		if err == nil {
+			return fmt.Errorf("Volume image still exists")
+		}
+	}
+
+	return nil
+}
+
+const testAccBlockStorageV3VolumeUploadImage_basic = `
+resource "openstack_blockstorage_volume_v3" "volume_1" {
+  name = "volume_1"
+  size = 1
+}
+
+resource "openstack_blockstorage_volume_upload_image_v3" "image_1" {
+  volume_id = "${openstack_blockstorage_volume_v3.volume_1.id}"
+  image_name = "image_1"
+  disk_format = "qcow2"
+  visibility = "private"
+}
+`
+
+const testAccBlockStorageV3VolumeUploadImage_protected = `
+resource "openstack_blockstorage_volume_v3" "volume_1" {
+  name = "volume_1"
+  size = 1
+}
+
+resource "openstack_blockstorage_volume_upload_image_v3" "image_1" {
+  volume_id = "${openstack_blockstorage_volume_v3.volume_1.id}"
+  image_name = "image_1"
+  protected = true
+}
+`
@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform/helper/hashcode"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/samuelbernardolip/gophercloud"
 	"github.com/samuelbernardolip/gophercloud/openstack/blockstorage/extensions/volumeactions"
 	"github.com/samuelbernardolip/gophercloud/openstack/blockstorage/v3/volumes"
@@ -27,6 +28,7 @@ func resourceBlockStorageVolumeV3() *schema.Resource {
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
 			Delete: schema.DefaultTimeout(10 * time.Minute),
 		},
 
@@ -83,12 +85,24 @@ func resourceBlockStorageVolumeV3() *schema.Resource {
 				Optional: true,
 				ForceNew: true,
 			},
-			"volume_type": &schema.Schema{
+			"backup_id": &schema.Schema{
 				Type:     schema.TypeString,
 				Optional: true,
 				ForceNew: true,
+			},
+			"volume_type": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: false,
 				Computed: true,
 			},
+			"migration_policy": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"never", "on-demand",
+				}, false),
+			},
 			"consistency_group_id": &schema.Schema{
 				Type:     schema.TypeString,
 				Optional: true,
@@ -135,19 +149,26 @@ func resourceBlockStorageVolumeV3Create(d *schema.ResourceData, meta interface{}
 		return fmt.Errorf("Error creating OpenStack block storage client: %s", err)
 	}
 
-	createOpts := &volumes.CreateOpts{
-		AvailabilityZone:   d.Get("availability_zone").(string),
-		ConsistencyGroupID: d.Get("consistency_group_id").(string),
-		Description:        d.Get("description").(string),
-		ImageID:            d.Get("image_id").(string),
-		Metadata:           resourceContainerMetadataV2(d),
-		Name:               d.Get("name").(string),
-		Size:               d.Get("size").(int),
-		SnapshotID:         d.Get("snapshot_id").(string),
-		SourceReplica:      d.Get("source_replica").(string),
-		SourceVolID:        d.Get("source_vol_id").(string),
-		VolumeType:         d.Get("volume_type").(string),
-		Multiattach:        d.Get("multiattach").(bool),
+	createOpts := &blockStorageVolumeV3CreateOpts{
+		CreateOpts: volumes.CreateOpts{
+			AvailabilityZone:   d.Get("availability_zone").(string),
+			ConsistencyGroupID: d.Get("consistency_group_id").(string),
+			Description:        d.Get("description").(string),
+			ImageID:            d.Get("image_id").(string),
+			Metadata:           resourceContainerMetadataV2(d),
+			Name:               d.Get("name").(string),
+			Size:               d.Get("size").(int),
+			SnapshotID:         d.Get("snapshot_id").(string),
+			SourceReplica:      d.Get("source_replica").(string),
+			SourceVolID:        d.Get("source_vol_id").(string),
+			VolumeType:         d.Get("volume_type").(string),
+			Multiattach:        d.Get("multiattach").(bool),
+		},
+		BackupID: d.Get("backup_id").(string),
+	}
+
+	if createOpts.BackupID != "" {
+		blockStorageClient.Microversion = blockStorageVolumeV3BackupMicroversion
 	}
 
 	log.Printf("[DEBUG] Create Options: %#v", createOpts)
@@ -157,13 +178,16 @@ func resourceBlockStorageVolumeV3Create(d *schema.ResourceData, meta interface{}
 	}
 	log.Printf("[INFO] Volume ID: %s", v.ID)
 
+	// Store the ID now
+	d.SetId(v.ID)
+
 	// Wait for the volume to become available.
 	log.Printf(
 		"[DEBUG] Waiting for volume (%s) to become available",
 		v.ID)
 
 	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"downloading", "creating"},
+		Pending:    []string{"downloading", "creating", "restoring-backup"},
 		Target:     []string{"available"},
 		Refresh:    VolumeV3StateRefreshFunc(blockStorageClient, v.ID),
 		Timeout:    d.Timeout(schema.TimeoutCreate),
@@ -178,8 +202,27 @@ func resourceBlockStorageVolumeV3Create(d *schema.ResourceData, meta interface{}
 			v.ID, err)
 	}
 
-	// Store the ID now
-	d.SetId(v.ID)
+	// Wait for the backup to finish restoring as well, so that it can be
+	// used again right away.
+	if createOpts.BackupID != "" {
+		log.Printf("[DEBUG] Waiting for backup (%s) to finish restoring", createOpts.BackupID)
+
+		stateConf := &resource.StateChangeConf{
+			Pending:    []string{"restoring"},
+			Target:     []string{"available"},
+			Refresh:    blockStorageBackupV3StateRefreshFunc(blockStorageClient, createOpts.BackupID),
+			Timeout:    d.Timeout(schema.TimeoutCreate),
+			Delay:      10 * time.Second,
+			MinTimeout: 3 * time.Second,
+		}
+
+		_, err = stateConf.WaitForState()
+		if err != nil {
+			return fmt.Errorf(
+				"Error waiting for backup (%s) to finish restoring: %s",
+				createOpts.BackupID, err)
+		}
+	}
 
 	return resourceBlockStorageVolumeV3Read(d, meta)
 }
@@ -287,6 +330,48 @@ func resourceBlockStorageVolumeV3Update(d *schema.ResourceData, meta interface{}
 		}
 	}
 
+	if d.HasChange("volume_type") {
+		oldType, newType := d.GetChange("volume_type")
+
+		changeTypeOpts := blockStorageVolumeV3ChangeTypeOpts{
+			NewType:         newType.(string),
+			MigrationPolicy: d.Get("migration_policy").(string),
+		}
+		log.Printf("[DEBUG] Change Type Options: %#v", changeTypeOpts)
+		err = blockStorageVolumeV3ChangeType(blockStorageClient, d.Id(), changeTypeOpts).ExtractErr()
+		if err != nil {
+			return fmt.Errorf(
+				"Error changing volume (%s) type (%s)",
+				d.Id(), err)
+		}
+
+		// The volume stays in retyping while it is migrated, which includes
+		// swapping the volume of an attached instance.
+		stateConf := &resource.StateChangeConf{
+			Pending:    []string{"retyping"},
+			Target:     []string{"available", "in-use"},
+			Refresh:    VolumeV3StateRefreshFunc(blockStorageClient, d.Id()),
+			Timeout:    d.Timeout(schema.TimeoutUpdate),
+			Delay:      10 * time.Second,
+			MinTimeout: 3 * time.Second,
+		}
+
+		rawVolume, err := stateConf.WaitForState()
+		if err != nil {
+			return fmt.Errorf(
+				"Error waiting for volume (%s) to be retyped (%s)",
+				d.Id(), err)
+		}
+
+		// A failed retype leaves the volume in its previous status and type.
+		if rawVolume.(*volumes.Volume).VolumeType == oldType.(string) {
+			return fmt.Errorf(
+				"Error changing volume (%s) type from %s to %s, "+
+					"a migration may be required, see migration_policy option",
+				d.Id(), oldType, newType)
+		}
+	}
+
 	_, err = volumes.Update(blockStorageClient, d.Id(), updateOpts).Extract()
 	if err != nil {
 		return fmt.Errorf("Error updating OpenStack volume: %s", err)
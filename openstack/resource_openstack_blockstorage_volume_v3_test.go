@@ -129,6 +129,74 @@ func TestAccBlockStorageV3Volume_timeout(t *testing.T) {
 	})
 }
 
+func TestAccBlockStorageV3Volume_retype(t *testing.T) {
+	var volume volumes.Volume
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckBlockStorageV3VolumeDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccBlockStorageV3Volume_retype,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBlockStorageV3VolumeExists("openstack_blockstorage_volume_v3.volume_1", &volume),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_volume_v3.volume_1", "volume_type", "volume_type_1"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccBlockStorageV3Volume_retype_update,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBlockStorageV3VolumeExists("openstack_blockstorage_volume_v3.volume_1", &volume),
+					resource.TestCheckResourceAttrPtr(
+						"openstack_blockstorage_volume_v3.volume_1", "id", &volume.ID),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_volume_v3.volume_1", "volume_type", "volume_type_2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBlockStorageV3Volume_retype_attached(t *testing.T) {
+	var volume volumes.Volume
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckBlockStorageV3VolumeDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccBlockStorageV3Volume_retype_attached,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBlockStorageV3VolumeExists("openstack_blockstorage_volume_v3.volume_1", &volume),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_volume_v3.volume_1", "volume_type", "volume_type_1"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccBlockStorageV3Volume_retype_attached_update,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBlockStorageV3VolumeExists("openstack_blockstorage_volume_v3.volume_1", &volume),
+					resource.TestCheckResourceAttrPtr(
+						"openstack_blockstorage_volume_v3.volume_1", "id", &volume.ID),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_volume_v3.volume_1", "volume_type", "volume_type_2"),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_volume_v3.volume_1", "attachment.#", "1"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckBlockStorageV3VolumeDestroy(s *terraform.State) error {
 	config := testAccProvider.Meta().(*Config)
 	blockStorageClient, err := config.blockStorageV3Client(OS_REGION_NAME)
@@ -316,3 +384,91 @@ resource "openstack_blockstorage_volume_v3" "volume_1" {
   }
 }
 `
+
+const testAccBlockStorageV3Volume_retype = `
+resource "openstack_blockstorage_volume_type_v3" "volume_type_1" {
+  name = "volume_type_1"
+}
+
+resource "openstack_blockstorage_volume_type_v3" "volume_type_2" {
+  name = "volume_type_2"
+}
+
+resource "openstack_blockstorage_volume_v3" "volume_1" {
+  name = "volume_1"
+  size = 1
+  volume_type = "${openstack_blockstorage_volume_type_v3.volume_type_1.name}"
+}
+`
+
+const testAccBlockStorageV3Volume_retype_update = `
+resource "openstack_blockstorage_volume_type_v3" "volume_type_1" {
+  name = "volume_type_1"
+}
+
+resource "openstack_blockstorage_volume_type_v3" "volume_type_2" {
+  name = "volume_type_2"
+}
+
+resource "openstack_blockstorage_volume_v3" "volume_1" {
+  name = "volume_1"
+  size = 1
+  volume_type = "${openstack_blockstorage_volume_type_v3.volume_type_2.name}"
+  migration_policy = "on-demand"
+}
+`
+
+var testAccBlockStorageV3Volume_retype_attached = fmt.Sprintf(`
+resource "openstack_blockstorage_volume_type_v3" "volume_type_1" {
+  name = "volume_type_1"
+}
+
+resource "openstack_blockstorage_volume_type_v3" "volume_type_2" {
+  name = "volume_type_2"
+}
+
+resource "openstack_compute_instance_v2" "instance_1" {
+  name            = "instance_1"
+  flavor_name     = "%s"
+  image_id        = "%s"
+}
+
+resource "openstack_blockstorage_volume_v3" "volume_1" {
+  name = "volume_1"
+  size = 1
+  volume_type = "${openstack_blockstorage_volume_type_v3.volume_type_1.name}"
+}
+
+resource "openstack_compute_volume_attach_v2" "va_1" {
+  instance_id = "${openstack_compute_instance_v2.instance_1.id}"
+  volume_id   = "${openstack_blockstorage_volume_v3.volume_1.id}"
+}
+`, OS_FLAVOR_NAME, OS_IMAGE_ID)
+
+var testAccBlockStorageV3Volume_retype_attached_update = fmt.Sprintf(`
+resource "openstack_blockstorage_volume_type_v3" "volume_type_1" {
+  name = "volume_type_1"
+}
+
+resource "openstack_blockstorage_volume_type_v3" "volume_type_2" {
+  name = "volume_type_2"
+}
+
+resource "openstack_compute_instance_v2" "instance_1" {
+  name            = "instance_1"
+  flavor_name     = "%s"
+  image_id        = "%s"
+}
+
+resource "openstack_blockstorage_volume_v3" "volume_1" {
+  name = "volume_1"
+  size = 1
+  volume_type = "${openstack_blockstorage_volume_type_v3.volume_type_2.name}"
+  migration_policy = "on-demand"
+}
+
+resource "openstack_compute_volume_attach_v2" "va_1" {
+  instance_id = "${openstack_compute_instance_v2.instance_1.id}"
+  volume_id   = "${openstack_blockstorage_volume_v3.volume_1.id}"
+}
+`, OS_FLAVOR_NAME, OS_IMAGE_ID)
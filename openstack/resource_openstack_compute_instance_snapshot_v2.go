@@ -0,0 +1,277 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+
+	"github.com/samuelbernardolip/gophercloud"
+	"github.com/samuelbernardolip/gophercloud/openstack/blockstorage/v3/snapshots"
+	"github.com/samuelbernardolip/gophercloud/openstack/compute/v2/servers"
+	"github.com/samuelbernardolip/gophercloud/openstack/imageservice/v2/images"
+)
+
+func resourceComputeInstanceSnapshotV2() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceComputeInstanceSnapshotV2Create,
+		Read:   resourceComputeInstanceSnapshotV2Read,
+		Delete: resourceComputeInstanceSnapshotV2Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"instance_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"metadata": &schema.Schema{
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"volume_backed": &schema.Schema{
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
+			"volume_snapshot_ids": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"status": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"size_bytes": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"checksum": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"created_at": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceComputeInstanceSnapshotV2Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	computeClient, err := config.computeV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack compute client: %s", err)
+	}
+
+	imageClient, err := config.imageV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack image client: %s", err)
+	}
+
+	instanceID := d.Get("instance_id").(string)
+	createOpts := &servers.CreateImageOpts{
+		Name:     d.Get("name").(string),
+		Metadata: resourceInstanceMetadataV2(d),
+	}
+
+	log.Printf("[DEBUG] Create Options: %#v", createOpts)
+	imageID, err := servers.CreateImage(computeClient, instanceID, createOpts).ExtractImageID()
+	if err != nil {
+		return fmt.Errorf("Error creating snapshot of instance %s: %s", instanceID, err)
+	}
+	log.Printf("[INFO] Instance snapshot image ID: %s", imageID)
+
+	// Store the ID now
+	d.SetId(imageID)
+
+	log.Printf("[DEBUG] Waiting for instance snapshot (%s) to become active", imageID)
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			string(images.ImageStatusQueued),
+			string(images.ImageStatusSaving),
+		},
+		Target:     []string{string(images.ImageStatusActive)},
+		Refresh:    resourceImagesImageV2RefreshFunc(imageClient, imageID),
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		Delay:      10 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, err = stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf("Error waiting for instance snapshot (%s) to become active: %s", imageID, err)
+	}
+
+	img, err := images.Get(imageClient, imageID).Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "instance snapshot")
+	}
+
+	// The image of a volume-backed instance only refers to the snapshots of
+	// its volumes, which are still being created once it is active.
+	snapshotIDs, err := computeInstanceSnapshotV2VolumeSnapshotIDs(img)
+	if err != nil {
+		return err
+	}
+
+	if len(snapshotIDs) > 0 {
+		blockStorageClient, err := config.blockStorageV3Client(GetRegion(d, config))
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack block storage client: %s", err)
+		}
+
+		for _, snapshotID := range snapshotIDs {
+			log.Printf("[DEBUG] Waiting for volume snapshot (%s) of instance snapshot (%s) to become available", snapshotID, imageID)
+
+			stateConf := &resource.StateChangeConf{
+				Pending:    []string{"creating"},
+				Target:     []string{"available"},
+				Refresh:    blockStorageSnapshotV3StateRefreshFunc(blockStorageClient, snapshotID),
+				Timeout:    d.Timeout(schema.TimeoutCreate),
+				Delay:      10 * time.Second,
+				MinTimeout: 3 * time.Second,
+			}
+
+			_, err = stateConf.WaitForState()
+			if err != nil {
+				return fmt.Errorf("Error waiting for volume snapshot (%s) of instance snapshot (%s) to become available: %s", snapshotID, imageID, err)
+			}
+		}
+	}
+
+	return resourceComputeInstanceSnapshotV2Read(d, meta)
+}
+
+func resourceComputeInstanceSnapshotV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	imageClient, err := config.imageV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack image client: %s", err)
+	}
+
+	img, err := images.Get(imageClient, d.Id()).Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "instance snapshot")
+	}
+
+	log.Printf("[DEBUG] Retrieved instance snapshot %s: %#v", d.Id(), img)
+
+	snapshotIDs, err := computeInstanceSnapshotV2VolumeSnapshotIDs(img)
+	if err != nil {
+		return err
+	}
+
+	// Nova copies the properties of the instance to the image as well, so
+	// only the metadata set in the configuration is read back.
+	metadata := make(map[string]string)
+	for key := range d.Get("metadata").(map[string]interface{}) {
+		if v, ok := img.Properties[key].(string); ok {
+			metadata[key] = v
+		}
+	}
+
+	if instanceID, ok := img.Properties["instance_uuid"].(string); ok {
+		d.Set("instance_id", instanceID)
+	}
+
+	d.Set("name", img.Name)
+	d.Set("metadata", metadata)
+	d.Set("volume_backed", len(snapshotIDs) > 0)
+	d.Set("volume_snapshot_ids", snapshotIDs)
+	d.Set("status", img.Status)
+	d.Set("size_bytes", img.SizeBytes)
+	d.Set("checksum", img.Checksum)
+	d.Set("created_at", img.CreatedAt.Format(time.RFC3339))
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceComputeInstanceSnapshotV2Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	imageClient, err := config.imageV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack image client: %s", err)
+	}
+
+	log.Printf("[DEBUG] Deleting instance snapshot %s", d.Id())
+	if err := images.Delete(imageClient, d.Id()).ExtractErr(); err != nil {
+		if _, ok := err.(gophercloud.ErrDefault404); !ok {
+			return fmt.Errorf("Error deleting instance snapshot %s: %s", d.Id(), err)
+		}
+	}
+
+	// The volume snapshots are not deleted together with the image.
+	snapshotIDs := d.Get("volume_snapshot_ids").([]interface{})
+	if len(snapshotIDs) == 0 {
+		d.SetId("")
+		return nil
+	}
+
+	blockStorageClient, err := config.blockStorageV3Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack block storage client: %s", err)
+	}
+
+	for _, v := range snapshotIDs {
+		snapshotID := v.(string)
+
+		log.Printf("[DEBUG] Deleting volume snapshot %s of instance snapshot %s", snapshotID, d.Id())
+		if err := snapshots.Delete(blockStorageClient, snapshotID).ExtractErr(); err != nil {
+			if _, ok := err.(gophercloud.ErrDefault404); ok {
+				continue
+			}
+			return fmt.Errorf("Error deleting volume snapshot %s of instance snapshot %s: %s", snapshotID, d.Id(), err)
+		}
+
+		stateConf := &resource.StateChangeConf{
+			Pending:    []string{"available", "deleting"},
+			Target:     []string{"deleted"},
+			Refresh:    blockStorageSnapshotV3StateRefreshFunc(blockStorageClient, snapshotID),
+			Timeout:    d.Timeout(schema.TimeoutDelete),
+			Delay:      10 * time.Second,
+			MinTimeout: 3 * time.Second,
+		}
+
+		_, err = stateConf.WaitForState()
+		if err != nil {
+			return fmt.Errorf("Error waiting for volume snapshot (%s) of instance snapshot (%s) to delete: %s", snapshotID, d.Id(), err)
+		}
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,143 @@
+package openstack
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/samuelbernardolip/gophercloud/openstack/blockstorage/v3/snapshots"
+	"github.com/samuelbernardolip/gophercloud/openstack/compute/v2/servers"
+	"github.com/samuelbernardolip/gophercloud/openstack/imageservice/v2/images"
+)
+
+func TestAccComputeV2InstanceSnapshot_basic(t *testing.T) {
+	var instance servers.Server
+	var image images.Image
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeV2InstanceSnapshotDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccComputeV2InstanceSnapshot_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeV2InstanceExists("openstack_compute_instance_v2.instance_1", &instance),
+					testAccCheckImagesImageV2Exists("openstack_compute_instance_snapshot_v2.snapshot_1", &image),
+					resource.TestCheckResourceAttrPtr(
+						"openstack_compute_instance_snapshot_v2.snapshot_1", "instance_id", &instance.ID),
+					resource.TestCheckResourceAttr(
+						"openstack_compute_instance_snapshot_v2.snapshot_1", "name", "snapshot_1"),
+					resource.TestCheckResourceAttr(
+						"openstack_compute_instance_snapshot_v2.snapshot_1", "metadata.foo", "bar"),
+					resource.TestCheckResourceAttr(
+						"openstack_compute_instance_snapshot_v2.snapshot_1", "status", "active"),
+					resource.TestCheckResourceAttr(
+						"openstack_compute_instance_snapshot_v2.snapshot_1", "volume_backed", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccComputeV2InstanceSnapshot_bootFromVolume(t *testing.T) {
+	var image images.Image
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeV2InstanceSnapshotDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccComputeV2InstanceSnapshot_bootFromVolume,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckImagesImageV2Exists("openstack_compute_instance_snapshot_v2.snapshot_1", &image),
+					resource.TestCheckResourceAttr(
+						"openstack_compute_instance_snapshot_v2.snapshot_1", "volume_backed", "true"),
+					resource.TestCheckResourceAttr(
+						"openstack_compute_instance_snapshot_v2.snapshot_1", "volume_snapshot_ids.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckComputeV2InstanceSnapshotDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	imageClient, err := config.imageV2Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack image client: %s", err)
+	}
+
+	blockStorageClient, err := config.blockStorageV3Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack block storage client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "openstack_compute_instance_snapshot_v2" {
+			continue
+		}
+
+		_, err := images.Get(imageClient, rs.Primary.ID).Extract()
+		if err == nil {
+			return fmt.Errorf("Instance snapshot still exists")
+		}
+
+		for k, v := range rs.Primary.Attributes {
+			if !strings.HasPrefix(k, "volume_snapshot_ids.") || k == "volume_snapshot_ids.#" {
+				continue
+			}
+
+			_, err := snapshots.Get(blockStorageClient, v).Extract()
+			if err == nil {
+				return fmt.Errorf("Volume snapshot %s of instance snapshot still exists", v)
+			}
+		}
+	}
+
+	return nil
+}
+
+var testAccComputeV2InstanceSnapshot_basic = fmt.Sprintf(`
+resource "openstack_compute_instance_v2" "instance_1" {
+  name = "instance_1"
+  security_groups = ["default"]
+  network {
+    uuid = "%s"
+  }
+}
+
+resource "openstack_compute_instance_snapshot_v2" "snapshot_1" {
+  instance_id = "${openstack_compute_instance_v2.instance_1.id}"
+  name = "snapshot_1"
+  metadata {
+    foo = "bar"
+  }
+}
+`, OS_NETWORK_ID)
+
+var testAccComputeV2InstanceSnapshot_bootFromVolume = fmt.Sprintf(`
+resource "openstack_compute_instance_v2" "instance_1" {
+  name = "instance_1"
+  security_groups = ["default"]
+  block_device {
+    uuid = "%s"
+    source_type = "image"
+    volume_size = 5
+    boot_index = 0
+    destination_type = "volume"
+    delete_on_termination = true
+  }
+  network {
+    uuid = "%s"
+  }
+}
+
+resource "openstack_compute_instance_snapshot_v2" "snapshot_1" {
+  instance_id = "${openstack_compute_instance_v2.instance_1.id}"
+  name = "snapshot_1"
+}
+`, OS_IMAGE_ID, OS_NETWORK_ID)
@@ -15,15 +15,18 @@ import (
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/samuelbernardolip/gophercloud"
+	"github.com/samuelbernardolip/gophercloud/openstack/blockstorage/v3/volumes"
 	"github.com/samuelbernardolip/gophercloud/openstack/compute/v2/extensions/availabilityzones"
 	"github.com/samuelbernardolip/gophercloud/openstack/compute/v2/extensions/bootfromvolume"
 	"github.com/samuelbernardolip/gophercloud/openstack/compute/v2/extensions/keypairs"
 	"github.com/samuelbernardolip/gophercloud/openstack/compute/v2/extensions/schedulerhints"
 	"github.com/samuelbernardolip/gophercloud/openstack/compute/v2/extensions/secgroups"
+	"github.com/samuelbernardolip/gophercloud/openstack/compute/v2/extensions/servergroups"
 	"github.com/samuelbernardolip/gophercloud/openstack/compute/v2/extensions/startstop"
 	"github.com/samuelbernardolip/gophercloud/openstack/compute/v2/flavors"
 	"github.com/samuelbernardolip/gophercloud/openstack/compute/v2/images"
 	"github.com/samuelbernardolip/gophercloud/openstack/compute/v2/servers"
+	"github.com/samuelbernardolip/gophercloud/openstack/networking/v2/ports"
 )
 
 func resourceComputeInstanceV2() *schema.Resource {
@@ -32,6 +35,11 @@ func resourceComputeInstanceV2() *schema.Resource {
 		Read:   resourceComputeInstanceV2Read,
 		Update: resourceComputeInstanceV2Update,
 		Delete: resourceComputeInstanceV2Delete,
+		Importer: &schema.ResourceImporter{
+			State: resourceComputeInstanceV2ImportState,
+		},
+
+		CustomizeDiff: computeInstanceV2CustomizeDiff,
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(30 * time.Minute),
@@ -55,13 +63,13 @@ func resourceComputeInstanceV2() *schema.Resource {
 			"image_id": &schema.Schema{
 				Type:     schema.TypeString,
 				Optional: true,
-				ForceNew: true,
+				ForceNew: false,
 				Computed: true,
 			},
 			"image_name": &schema.Schema{
 				Type:     schema.TypeString,
 				Optional: true,
-				ForceNew: true,
+				ForceNew: false,
 				Computed: true,
 			},
 			"flavor_id": &schema.Schema{
@@ -87,7 +95,7 @@ func resourceComputeInstanceV2() *schema.Resource {
 			"user_data": &schema.Schema{
 				Type:     schema.TypeString,
 				Optional: true,
-				ForceNew: true,
+				ForceNew: false,
 				// just stash the hash for state & diff comparisons
 				StateFunc: func(v interface{}) string {
 					switch v.(type) {
@@ -199,7 +207,7 @@ func resourceComputeInstanceV2() *schema.Resource {
 			"key_pair": &schema.Schema{
 				Type:     schema.TypeString,
 				Optional: true,
-				ForceNew: true,
+				ForceNew: false,
 			},
 			"block_device": &schema.Schema{
 				Type:     schema.TypeList,
@@ -343,6 +351,11 @@ func resourceComputeInstanceV2() *schema.Resource {
 				Optional: true,
 				Default:  false,
 			},
+			"allow_rebuild": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"all_metadata": &schema.Schema{
 				Type:     schema.TypeMap,
 				Computed: true,
@@ -353,10 +366,16 @@ func resourceComputeInstanceV2() *schema.Resource {
 				ForceNew: false,
 				Default:  "active",
 				ValidateFunc: validation.StringInSlice([]string{
-					"active", "shutoff",
+					"active", "shutoff", "shelved", "shelved_offloaded",
+					"paused", "suspended", "rescue",
 				}, true),
 				DiffSuppressFunc: suppressPowerStateDiffs,
 			},
+			"rescue_image_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: false,
+			},
 			"vendor_options": &schema.Schema{
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -502,25 +521,9 @@ func resourceComputeInstanceV2Create(d *schema.ResourceData, meta interface{}) e
 	}
 
 	vmState := d.Get("power_state").(string)
-	if strings.ToLower(vmState) == "shutoff" {
-		err = startstop.Stop(computeClient, d.Id()).ExtractErr()
-		if err != nil {
-			return fmt.Errorf("Error stopping OpenStack instance: %s", err)
-		}
-		stopStateConf := &resource.StateChangeConf{
-			//Pending:    []string{"ACTIVE"},
-			Target:     []string{"SHUTOFF"},
-			Refresh:    ServerV2StateRefreshFunc(computeClient, d.Id()),
-			Timeout:    d.Timeout(schema.TimeoutCreate),
-			Delay:      10 * time.Second,
-			MinTimeout: 3 * time.Second,
-		}
-
-		log.Printf("[DEBUG] Waiting for instance (%s) to stop", d.Id())
-		_, err = stopStateConf.WaitForState()
-		if err != nil {
-			return fmt.Errorf("Error waiting for instance (%s) to become inactive(shutoff): %s", d.Id(), err)
-		}
+	err = computeInstanceV2SetPowerState(computeClient, d, "active", vmState, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return err
 	}
 
 	return resourceComputeInstanceV2Read(d, meta)
@@ -628,7 +631,8 @@ func resourceComputeInstanceV2Read(d *schema.ResourceData, meta interface{}) err
 	// Set the current power_state
 	currentStatus := strings.ToLower(server.Status)
 	switch currentStatus {
-	case "active", "shutoff", "error", "migrating":
+	case "active", "shutoff", "shelved", "shelved_offloaded", "paused",
+		"suspended", "rescue", "error", "migrating":
 		d.Set("power_state", currentStatus)
 	default:
 		return fmt.Errorf("Invalid power_state for instance %s: %s", d.Id(), server.Status)
@@ -656,47 +660,24 @@ func resourceComputeInstanceV2Update(d *schema.ResourceData, meta interface{}) e
 		}
 	}
 
-	if d.HasChange("power_state") {
-		vmState := d.Get("power_state").(string)
-		if strings.ToLower(vmState) == "shutoff" {
-			err = startstop.Stop(computeClient, d.Id()).ExtractErr()
-			if err != nil {
-				return fmt.Errorf("Error stopping OpenStack instance: %s", err)
-			}
-			stopStateConf := &resource.StateChangeConf{
-				//Pending:    []string{"ACTIVE"},
-				Target:     []string{"SHUTOFF"},
-				Refresh:    ServerV2StateRefreshFunc(computeClient, d.Id()),
-				Timeout:    d.Timeout(schema.TimeoutUpdate),
-				Delay:      10 * time.Second,
-				MinTimeout: 3 * time.Second,
-			}
+	// A new rescue image only takes effect when the instance is rescued again.
+	rescueImageChanged := d.HasChange("rescue_image_id") && strings.ToLower(d.Get("power_state").(string)) == "rescue"
 
-			log.Printf("[DEBUG] Waiting for instance (%s) to stop", d.Id())
-			_, err = stopStateConf.WaitForState()
+	if d.HasChange("power_state") || rescueImageChanged {
+		oldState, newState := d.GetChange("power_state")
+		currentState := strings.ToLower(oldState.(string))
+
+		if currentState == "rescue" && rescueImageChanged {
+			err = computeInstanceV2LeavePowerState(computeClient, d.Id(), currentState, d.Timeout(schema.TimeoutUpdate))
 			if err != nil {
-				return fmt.Errorf("Error waiting for instance (%s) to become inactive(shutoff): %s", d.Id(), err)
+				return err
 			}
+			currentState = "active"
 		}
-		if strings.ToLower(vmState) == "active" {
-			err = startstop.Start(computeClient, d.Id()).ExtractErr()
-			if err != nil {
-				return fmt.Errorf("Error starting OpenStack instance: %s", err)
-			}
-			startStateConf := &resource.StateChangeConf{
-				//Pending:    []string{"SHUTOFF"},
-				Target:     []string{"ACTIVE"},
-				Refresh:    ServerV2StateRefreshFunc(computeClient, d.Id()),
-				Timeout:    d.Timeout(schema.TimeoutUpdate),
-				Delay:      10 * time.Second,
-				MinTimeout: 3 * time.Second,
-			}
 
-			log.Printf("[DEBUG] Waiting for instance (%s) to start", d.Id())
-			_, err = startStateConf.WaitForState()
-			if err != nil {
-				return fmt.Errorf("Error waiting for instance (%s) to become active: %s", d.Id(), err)
-			}
+		err = computeInstanceV2SetPowerState(computeClient, d, currentState, newState.(string), d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return err
 		}
 	}
 
@@ -780,6 +761,48 @@ func resourceComputeInstanceV2Update(d *schema.ResourceData, meta interface{}) e
 		}
 	}
 
+	if computeInstanceV2HasRebuildChange(d) {
+		imageId, err := getImageIDFromConfig(computeClient, d)
+		if err != nil {
+			return err
+		}
+
+		if imageId == "" {
+			return fmt.Errorf("Error rebuilding OpenStack server (%s): an image is required to rebuild the instance", d.Id())
+		}
+
+		// Only the rebuild request needs the microversion, so it is set on a
+		// copy of the client that the rest of the update keeps using.
+		rebuildOpts, microversion := expandComputeInstanceV2RebuildOpts(d, imageId)
+		rebuildClient := *computeClient
+		if microversion != "" {
+			rebuildClient.Microversion = microversion
+		}
+
+		log.Printf("[DEBUG] Rebuild configuration: %#v", rebuildOpts)
+		_, err = servers.Rebuild(&rebuildClient, d.Id(), rebuildOpts).Extract()
+		if err != nil {
+			return fmt.Errorf("Error rebuilding OpenStack server (%s): %s", d.Id(), err)
+		}
+
+		// Wait for the instance to finish rebuilding.
+		log.Printf("[DEBUG] Waiting for instance (%s) to finish rebuilding", d.Id())
+
+		stateConf := &resource.StateChangeConf{
+			Pending:    []string{"REBUILD"},
+			Target:     []string{"ACTIVE", "SHUTOFF"},
+			Refresh:    ServerV2StateRefreshFunc(computeClient, d.Id()),
+			Timeout:    d.Timeout(schema.TimeoutUpdate),
+			Delay:      10 * time.Second,
+			MinTimeout: 3 * time.Second,
+		}
+
+		_, err = stateConf.WaitForState()
+		if err != nil {
+			return fmt.Errorf("Error waiting for instance (%s) to rebuild: %s", d.Id(), err)
+		}
+	}
+
 	if d.HasChange("flavor_id") || d.HasChange("flavor_name") {
 		// Get vendor_options
 		vendorOptionsRaw := d.Get("vendor_options").(*schema.Set)
@@ -934,6 +957,92 @@ func resourceComputeInstanceV2Delete(d *schema.ResourceData, meta interface{}) e
 	return nil
 }
 
+// resourceComputeInstanceV2ImportState rebuilds the arguments that Read can't
+// determine on its own. Nova does not remember how a server was requested,
+// so the network, block_device and scheduler_hints blocks are reconstructed
+// from the server's current ports, volumes and server group membership.
+func resourceComputeInstanceV2ImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	config := meta.(*Config)
+	computeClient, err := config.computeV2Client(GetRegion(d, config))
+	if err != nil {
+		return nil, fmt.Errorf("Error creating OpenStack compute client: %s", err)
+	}
+
+	server, err := servers.Get(computeClient, d.Id()).Extract()
+	if err != nil {
+		return nil, fmt.Errorf("Error retrieving OpenStack server %s: %s", d.Id(), err)
+	}
+
+	log.Printf("[DEBUG] Retrieved Server %s for import: %+v", d.Id(), server)
+
+	// The root device name and delete_on_termination flags are only returned
+	// by newer microversions. Use a copy of the client so the microversion
+	// doesn't leak into the Read below.
+	importClient := *computeClient
+	importClient.Microversion = computeInstanceV2ImportMicroversion
+
+	var importServer computeInstanceV2ImportServer
+	err = servers.Get(&importClient, d.Id()).ExtractInto(&importServer)
+	if err != nil {
+		log.Printf("[DEBUG] Unable to retrieve OpenStack server %s with microversion %s: %s",
+			d.Id(), computeInstanceV2ImportMicroversion, err)
+
+		err = servers.Get(computeClient, d.Id()).ExtractInto(&importServer)
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving OpenStack server %s volume attachments: %s", d.Id(), err)
+		}
+	}
+
+	// A server that was booted from a volume has no image. Its boot volume
+	// has to be expressed as a block_device before Read is called, otherwise
+	// Read will try to look up an image that doesn't exist.
+	if imageID, ok := server.Image["id"].(string); !ok || imageID == "" {
+		blockStorageClient, err := config.blockStorageV3Client(GetRegion(d, config))
+		if err != nil {
+			return nil, fmt.Errorf("Error creating OpenStack block storage client: %s", err)
+		}
+
+		blockDevice, err := getInstanceBootBlockDevice(blockStorageClient, d.Id(), importServer)
+		if err != nil {
+			return nil, err
+		}
+
+		d.Set("block_device", []map[string]interface{}{blockDevice})
+	}
+
+	// Rebuild the network blocks from the server's Neutron ports so Read can
+	// match them against the server's addresses. nova-network environments
+	// fall back to the default network handling in flattenInstanceNetworks.
+	if _, ok := os.LookupEnv("OS_NOVA_NETWORK"); !ok {
+		networkClient, err := config.networkingV2Client(GetRegion(d, config))
+		if err == nil {
+			networks, err := getInstanceNetworksNeutron(networkClient, d.Id())
+			if err != nil {
+				return nil, err
+			}
+
+			d.Set("network", networks)
+		} else {
+			log.Printf("[DEBUG] Unable to obtain a network client: %s", err)
+		}
+	}
+
+	d.Set("key_pair", server.KeyName)
+	d.Set("metadata", server.Metadata)
+
+	schedulerHints, err := getInstanceSchedulerHints(computeClient, server)
+	if err != nil {
+		return nil, err
+	}
+	d.Set("scheduler_hints", schedulerHints)
+
+	if err := resourceComputeInstanceV2Read(d, meta); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
 // ServerV2StateRefreshFunc returns a resource.StateRefreshFunc that is used to watch
 // an OpenStack instance.
 func ServerV2StateRefreshFunc(client *gophercloud.ServiceClient, instanceID string) resource.StateRefreshFunc {
@@ -1124,6 +1233,116 @@ func setImageInformation(computeClient *gophercloud.ServiceClient, server *serve
 	return nil
 }
 
+// getInstanceBootBlockDevice returns the block_device entry for the volume
+// that is attached to the instance as its root device. If the root device
+// name is unknown, the only bootable attached volume is used.
+func getInstanceBootBlockDevice(client *gophercloud.ServiceClient, instanceID string, importServer computeInstanceV2ImportServer) (map[string]interface{}, error) {
+	var bootVolumes []computeInstanceV2ImportVolume
+	var deleteOnTermination []bool
+	for _, attached := range importServer.VolumesAttached {
+		var volume computeInstanceV2ImportVolume
+		err := volumes.Get(client, attached.ID).ExtractInto(&volume)
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving OpenStack volume %s: %s", attached.ID, err)
+		}
+
+		log.Printf("[DEBUG] Retrieved volume %s: %+v", attached.ID, volume)
+
+		if importServer.RootDeviceName != "" {
+			if computeInstanceV2IsRootVolume(&volume.Volume, instanceID, importServer.RootDeviceName) {
+				return computeInstanceV2BootBlockDevice(&volume, attached.DeleteOnTermination), nil
+			}
+			continue
+		}
+
+		if volume.Bootable == "true" {
+			bootVolumes = append(bootVolumes, volume)
+			deleteOnTermination = append(deleteOnTermination, attached.DeleteOnTermination)
+		}
+	}
+
+	if importServer.RootDeviceName != "" {
+		return nil, fmt.Errorf("Unable to find the volume attached to OpenStack server %s as %s",
+			instanceID, importServer.RootDeviceName)
+	}
+
+	if len(bootVolumes) != 1 {
+		return nil, fmt.Errorf("Unable to determine the boot volume of OpenStack server %s: "+
+			"its root device name is unknown and %d attached volumes are bootable", instanceID, len(bootVolumes))
+	}
+
+	return computeInstanceV2BootBlockDevice(&bootVolumes[0], deleteOnTermination[0]), nil
+}
+
+// getInstanceNetworksNeutron builds a list of network blocks for an instance
+// from the Neutron ports that are bound to it.
+func getInstanceNetworksNeutron(client *gophercloud.ServiceClient, instanceID string) ([]map[string]interface{}, error) {
+	listOpts := ports.ListOpts{
+		DeviceID: instanceID,
+	}
+
+	allPages, err := ports.List(client, listOpts).AllPages()
+	if err != nil {
+		return nil, fmt.Errorf("Unable to retrieve ports for instance %s from the Network API: %s", instanceID, err)
+	}
+
+	allPorts, err := ports.ExtractPorts(allPages)
+	if err != nil {
+		return nil, fmt.Errorf("Unable to retrieve ports for instance %s from the Network API: %s", instanceID, err)
+	}
+
+	networks := []map[string]interface{}{}
+	for _, port := range allPorts {
+		networkInfo, err := getInstanceNetworkInfoNeutron(client, "id", port.NetworkID)
+		if err != nil {
+			return nil, err
+		}
+
+		networks = append(networks, map[string]interface{}{
+			"uuid": networkInfo["uuid"],
+			"name": networkInfo["name"],
+			"port": port.ID,
+		})
+	}
+
+	log.Printf("[DEBUG] getInstanceNetworksNeutron: %#v", networks)
+	return networks, nil
+}
+
+// getInstanceSchedulerHints determines the scheduler_hints of an instance.
+// Only the server group membership is retained by Nova, so that is the only
+// hint that can be recovered. This is best-effort: the group is only set when
+// exactly one server group of the instance's project has it as a member.
+func getInstanceSchedulerHints(client *gophercloud.ServiceClient, server *servers.Server) ([]map[string]interface{}, error) {
+	allPages, err := servergroups.List(client).AllPages()
+	if err != nil {
+		return nil, fmt.Errorf("Unable to retrieve OpenStack server groups: %s", err)
+	}
+
+	var s struct {
+		ServerGroups []computeInstanceV2ImportServerGroup `json:"server_groups"`
+	}
+	err = allPages.(servergroups.ServerGroupPage).ExtractInto(&s)
+	if err != nil {
+		return nil, fmt.Errorf("Unable to retrieve OpenStack server groups: %s", err)
+	}
+
+	schedulerHints := []map[string]interface{}{}
+	if group := computeInstanceV2SchedulerHintsGroup(s.ServerGroups, server.TenantID, server.ID); group != "" {
+		schedulerHints = append(schedulerHints, map[string]interface{}{
+			"group":                 group,
+			"different_host":        []interface{}{},
+			"same_host":             []interface{}{},
+			"query":                 []interface{}{},
+			"target_cell":           "",
+			"build_near_host_ip":    "",
+			"additional_properties": map[string]interface{}{},
+		})
+	}
+
+	return schedulerHints, nil
+}
+
 func getFlavorID(client *gophercloud.ServiceClient, d *schema.ResourceData) (string, error) {
 	flavorId := d.Get("flavor_id").(string)
 
@@ -1244,5 +1463,10 @@ func suppressPowerStateDiffs(k, old, new string, d *schema.ResourceData) bool {
 		return true
 	}
 
+	// Nova may offload a shelved instance at any time.
+	if old == "shelved_offloaded" && strings.ToLower(new) == "shelved" {
+		return true
+	}
+
 	return false
 }
@@ -0,0 +1,240 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+
+	"github.com/samuelbernardolip/gophercloud/openstack/compute/v2/extensions/quotasets"
+)
+
+func resourceComputeQuotasetV2() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceComputeQuotasetV2Create,
+		Read:   resourceComputeQuotasetV2Read,
+		Update: resourceComputeQuotasetV2Update,
+		Delete: resourceComputeQuotasetV2Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"project_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"fixed_ips": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+
+			"floating_ips": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+
+			"injected_file_content_bytes": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+
+			"injected_file_path_bytes": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+
+			"injected_files": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+
+			"key_pairs": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+
+			"metadata_items": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+
+			"ram": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+
+			"security_group_rules": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+
+			"security_groups": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+
+			"cores": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+
+			"instances": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+
+			"server_groups": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+
+			"server_group_members": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceComputeQuotasetV2Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	computeClient, err := config.computeV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack compute client: %s", err)
+	}
+
+	projectID := d.Get("project_id").(string)
+	updateOpts := quotasets.UpdateOpts{
+		FixedIPs:                 quotaValue(d, "fixed_ips"),
+		FloatingIPs:              quotaValue(d, "floating_ips"),
+		InjectedFileContentBytes: quotaValue(d, "injected_file_content_bytes"),
+		InjectedFilePathBytes:    quotaValue(d, "injected_file_path_bytes"),
+		InjectedFiles:            quotaValue(d, "injected_files"),
+		KeyPairs:                 quotaValue(d, "key_pairs"),
+		MetadataItems:            quotaValue(d, "metadata_items"),
+		RAM:                      quotaValue(d, "ram"),
+		SecurityGroupRules:       quotaValue(d, "security_group_rules"),
+		SecurityGroups:           quotaValue(d, "security_groups"),
+		Cores:                    quotaValue(d, "cores"),
+		Instances:                quotaValue(d, "instances"),
+		ServerGroups:             quotaValue(d, "server_groups"),
+		ServerGroupMembers:       quotaValue(d, "server_group_members"),
+	}
+
+	log.Printf("[DEBUG] openstack_compute_quotaset_v2 create options: %#v", updateOpts)
+	_, err = quotasets.Update(computeClient, projectID, updateOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error creating openstack_compute_quotaset_v2 for project %s: %s", projectID, err)
+	}
+
+	d.SetId(projectID)
+
+	return resourceComputeQuotasetV2Read(d, meta)
+}
+
+func resourceComputeQuotasetV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	computeClient, err := config.computeV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack compute client: %s", err)
+	}
+
+	q, err := quotasets.Get(computeClient, d.Id()).Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "openstack_compute_quotaset_v2")
+	}
+
+	log.Printf("[DEBUG] Retrieved openstack_compute_quotaset_v2 %s: %#v", d.Id(), q)
+
+	d.Set("project_id", d.Id())
+	d.Set("region", GetRegion(d, config))
+	d.Set("fixed_ips", q.FixedIPs)
+	d.Set("floating_ips", q.FloatingIPs)
+	d.Set("injected_file_content_bytes", q.InjectedFileContentBytes)
+	d.Set("injected_file_path_bytes", q.InjectedFilePathBytes)
+	d.Set("injected_files", q.InjectedFiles)
+	d.Set("key_pairs", q.KeyPairs)
+	d.Set("metadata_items", q.MetadataItems)
+	d.Set("ram", q.RAM)
+	d.Set("security_group_rules", q.SecurityGroupRules)
+	d.Set("security_groups", q.SecurityGroups)
+	d.Set("cores", q.Cores)
+	d.Set("instances", q.Instances)
+	d.Set("server_groups", q.ServerGroups)
+	d.Set("server_group_members", q.ServerGroupMembers)
+
+	return nil
+}
+
+func resourceComputeQuotasetV2Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	computeClient, err := config.computeV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack compute client: %s", err)
+	}
+
+	updateOpts := quotasets.UpdateOpts{
+		FixedIPs:                 quotaChangedValue(d, "fixed_ips"),
+		FloatingIPs:              quotaChangedValue(d, "floating_ips"),
+		InjectedFileContentBytes: quotaChangedValue(d, "injected_file_content_bytes"),
+		InjectedFilePathBytes:    quotaChangedValue(d, "injected_file_path_bytes"),
+		InjectedFiles:            quotaChangedValue(d, "injected_files"),
+		KeyPairs:                 quotaChangedValue(d, "key_pairs"),
+		MetadataItems:            quotaChangedValue(d, "metadata_items"),
+		RAM:                      quotaChangedValue(d, "ram"),
+		SecurityGroupRules:       quotaChangedValue(d, "security_group_rules"),
+		SecurityGroups:           quotaChangedValue(d, "security_groups"),
+		Cores:                    quotaChangedValue(d, "cores"),
+		Instances:                quotaChangedValue(d, "instances"),
+		ServerGroups:             quotaChangedValue(d, "server_groups"),
+		ServerGroupMembers:       quotaChangedValue(d, "server_group_members"),
+	}
+
+	log.Printf("[DEBUG] openstack_compute_quotaset_v2 %s update options: %#v", d.Id(), updateOpts)
+	_, err = quotasets.Update(computeClient, d.Id(), updateOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error updating openstack_compute_quotaset_v2 %s: %s", d.Id(), err)
+	}
+
+	return resourceComputeQuotasetV2Read(d, meta)
+}
+
+func resourceComputeQuotasetV2Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	computeClient, err := config.computeV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack compute client: %s", err)
+	}
+
+	log.Printf("[DEBUG] Restoring default quotas for openstack_compute_quotaset_v2 %s", d.Id())
+	err = quotasets.Delete(computeClient, d.Id()).ExtractErr()
+	if err != nil {
+		return CheckDeleted(d, err, "Error restoring default quotas for openstack_compute_quotaset_v2")
+	}
+
+	return nil
+}
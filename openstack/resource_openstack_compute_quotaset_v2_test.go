@@ -0,0 +1,85 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccComputeV2Quotaset_basic(t *testing.T) {
+	projectName := fmt.Sprintf("tf_test_%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIdentityV3ProjectDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccComputeV2Quotaset_basic(projectName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"openstack_compute_quotaset_v2.quotaset_1", "project_id",
+						"openstack_identity_project_v3.project_1", "id"),
+					resource.TestCheckResourceAttr(
+						"openstack_compute_quotaset_v2.quotaset_1", "cores", "4"),
+					resource.TestCheckResourceAttr(
+						"openstack_compute_quotaset_v2.quotaset_1", "instances", "5"),
+					resource.TestCheckResourceAttr(
+						"openstack_compute_quotaset_v2.quotaset_1", "ram", "8192"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccComputeV2Quotaset_update(projectName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"openstack_compute_quotaset_v2.quotaset_1", "project_id",
+						"openstack_identity_project_v3.project_1", "id"),
+					resource.TestCheckResourceAttr(
+						"openstack_compute_quotaset_v2.quotaset_1", "cores", "8"),
+					resource.TestCheckResourceAttr(
+						"openstack_compute_quotaset_v2.quotaset_1", "instances", "10"),
+					resource.TestCheckResourceAttr(
+						"openstack_compute_quotaset_v2.quotaset_1", "ram", "16384"),
+					resource.TestCheckResourceAttr(
+						"openstack_compute_quotaset_v2.quotaset_1", "key_pairs", "20"),
+				),
+			},
+		},
+	})
+}
+
+func testAccComputeV2Quotaset_basic(projectName string) string {
+	return fmt.Sprintf(`
+resource "openstack_identity_project_v3" "project_1" {
+  name = "%s"
+}
+
+resource "openstack_compute_quotaset_v2" "quotaset_1" {
+  project_id = "${openstack_identity_project_v3.project_1.id}"
+  cores      = 4
+  instances  = 5
+  ram        = 8192
+}
+`, projectName)
+}
+
+func testAccComputeV2Quotaset_update(projectName string) string {
+	return fmt.Sprintf(`
+resource "openstack_identity_project_v3" "project_1" {
+  name = "%s"
+}
+
+resource "openstack_compute_quotaset_v2" "quotaset_1" {
+  project_id = "${openstack_identity_project_v3.project_1.id}"
+  cores      = 8
+  instances  = 10
+  ram        = 16384
+  key_pairs  = 20
+}
+`, projectName)
+}
@@ -0,0 +1,216 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/samuelbernardolip/gophercloud/openstack/identity/v3/applicationcredentials"
+)
+
+func resourceIdentityApplicationCredentialV3() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceIdentityApplicationCredentialV3Create,
+		Read:   resourceIdentityApplicationCredentialV3Read,
+		Delete: resourceIdentityApplicationCredentialV3Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"unrestricted": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"secret": &schema.Schema{
+				Type:      schema.TypeString,
+				Optional:  true,
+				Computed:  true,
+				Sensitive: true,
+				ForceNew:  true,
+			},
+
+			"project_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"roles": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"access_rules": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"path": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"method": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"POST", "GET", "HEAD", "PATCH", "PUT", "DELETE",
+							}, false),
+						},
+						"service": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+
+			"expires_at": &schema.Schema{
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				ValidateFunc:     validation.ValidateRFC3339TimeString,
+				DiffSuppressFunc: suppressEquivilentTimeDiffs,
+			},
+		},
+	}
+}
+
+func resourceIdentityApplicationCredentialV3Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	identityClient, err := config.identityV3Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack identity client: %s", err)
+	}
+
+	userID, err := identityV3TokenUserID(identityClient, config.OsClient.TokenID)
+	if err != nil {
+		return fmt.Errorf("Error retrieving the user of the OpenStack identity token: %s", err)
+	}
+
+	createOpts := applicationcredentials.CreateOpts{
+		Name:         d.Get("name").(string),
+		Description:  d.Get("description").(string),
+		Unrestricted: d.Get("unrestricted").(bool),
+		Roles:        expandIdentityApplicationCredentialRolesV3(d.Get("roles").(*schema.Set).List()),
+		AccessRules:  expandIdentityApplicationCredentialAccessRulesV3(d.Get("access_rules").(*schema.Set).List()),
+	}
+
+	if v, ok := d.GetOk("expires_at"); ok {
+		expiresAt, err := time.Parse(time.RFC3339, v.(string))
+		if err != nil {
+			return fmt.Errorf("Error parsing expires_at: %s", err)
+		}
+		createOpts.ExpiresAt = &expiresAt
+	}
+
+	log.Printf("[DEBUG] Create Options: %#v", createOpts)
+
+	// Add the secret after logging the create options.
+	createOpts.Secret = d.Get("secret").(string)
+
+	applicationCredential, err := applicationcredentials.Create(identityClient, userID, createOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack application credential: %s", err)
+	}
+
+	d.SetId(applicationCredential.ID)
+
+	// Keystone only returns the secret in the response of the create request.
+	d.Set("secret", applicationCredential.Secret)
+
+	return resourceIdentityApplicationCredentialV3Read(d, meta)
+}
+
+func resourceIdentityApplicationCredentialV3Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	identityClient, err := config.identityV3Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack identity client: %s", err)
+	}
+
+	userID, err := identityV3TokenUserID(identityClient, config.OsClient.TokenID)
+	if err != nil {
+		return fmt.Errorf("Error retrieving the user of the OpenStack identity token: %s", err)
+	}
+
+	applicationCredential, err := applicationcredentials.Get(identityClient, userID, d.Id()).Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "application credential")
+	}
+
+	log.Printf("[DEBUG] Retrieved OpenStack application credential: %#v", applicationCredential)
+
+	d.Set("name", applicationCredential.Name)
+	d.Set("description", applicationCredential.Description)
+	d.Set("unrestricted", applicationCredential.Unrestricted)
+	d.Set("project_id", applicationCredential.ProjectID)
+	d.Set("region", GetRegion(d, config))
+
+	if err := d.Set("roles", flattenIdentityApplicationCredentialRolesV3(applicationCredential.Roles)); err != nil {
+		log.Printf("[DEBUG] Unable to set roles: %s", err)
+	}
+
+	if err := d.Set("access_rules", flattenIdentityApplicationCredentialAccessRulesV3(applicationCredential.AccessRules)); err != nil {
+		log.Printf("[DEBUG] Unable to set access_rules: %s", err)
+	}
+
+	if applicationCredential.ExpiresAt.IsZero() {
+		d.Set("expires_at", "")
+	} else {
+		d.Set("expires_at", applicationCredential.ExpiresAt.UTC().Format(time.RFC3339))
+	}
+
+	return nil
+}
+
+func resourceIdentityApplicationCredentialV3Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	identityClient, err := config.identityV3Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack identity client: %s", err)
+	}
+
+	userID, err := identityV3TokenUserID(identityClient, config.OsClient.TokenID)
+	if err != nil {
+		return fmt.Errorf("Error retrieving the user of the OpenStack identity token: %s", err)
+	}
+
+	err = applicationcredentials.Delete(identityClient, userID, d.Id()).ExtractErr()
+	if err != nil {
+		return CheckDeleted(d, err, "Error deleting OpenStack application credential")
+	}
+
+	return nil
+}
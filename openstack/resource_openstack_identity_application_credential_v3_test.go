@@ -0,0 +1,175 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+
+	"github.com/samuelbernardolip/gophercloud/openstack/identity/v3/applicationcredentials"
+)
+
+func TestAccIdentityV3ApplicationCredential_basic(t *testing.T) {
+	var applicationCredential applicationcredentials.ApplicationCredential
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIdentityV3ApplicationCredentialDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccIdentityV3ApplicationCredential_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIdentityV3ApplicationCredentialExists("openstack_identity_application_credential_v3.app_cred_1", &applicationCredential),
+					resource.TestCheckResourceAttrPtr(
+						"openstack_identity_application_credential_v3.app_cred_1", "name", &applicationCredential.Name),
+					resource.TestCheckResourceAttr(
+						"openstack_identity_application_credential_v3.app_cred_1", "description", "wrf Terraform application credential"),
+					resource.TestCheckResourceAttr(
+						"openstack_identity_application_credential_v3.app_cred_1", "unrestricted", "false"),
+					resource.TestCheckResourceAttr(
+						"openstack_identity_application_credential_v3.app_cred_1", "expires_at", "2219-02-13T12:12:12Z"),
+					resource.TestCheckResourceAttrSet(
+						"openstack_identity_application_credential_v3.app_cred_1", "secret"),
+					resource.TestCheckResourceAttrSet(
+						"openstack_identity_application_credential_v3.app_cred_1", "project_id"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccIdentityV3ApplicationCredential_custom_secret,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIdentityV3ApplicationCredentialExists("openstack_identity_application_credential_v3.app_cred_1", &applicationCredential),
+					resource.TestCheckResourceAttr(
+						"openstack_identity_application_credential_v3.app_cred_1", "unrestricted", "true"),
+					resource.TestCheckResourceAttr(
+						"openstack_identity_application_credential_v3.app_cred_1", "secret", "foo"),
+					resource.TestCheckResourceAttr(
+						"openstack_identity_application_credential_v3.app_cred_1", "expires_at", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIdentityV3ApplicationCredential_accessRules(t *testing.T) {
+	var applicationCredential applicationcredentials.ApplicationCredential
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIdentityV3ApplicationCredentialDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccIdentityV3ApplicationCredential_accessRules,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIdentityV3ApplicationCredentialExists("openstack_identity_application_credential_v3.app_cred_1", &applicationCredential),
+					resource.TestCheckResourceAttr(
+						"openstack_identity_application_credential_v3.app_cred_1", "access_rules.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIdentityV3ApplicationCredentialDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	identityClient, err := config.identityV3Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack identity client: %s", err)
+	}
+
+	userID, err := identityV3TokenUserID(identityClient, config.OsClient.TokenID)
+	if err != nil {
+		return err
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "openstack_identity_application_credential_v3" {
+			continue
+		}
+
+		_, err := applicationcredentials.Get(identityClient, userID, rs.Primary.ID).Extract()
+		if err == nil {
+			return fmt.Errorf("Application credential still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckIdentityV3ApplicationCredentialExists(n string, applicationCredential *applicationcredentials.ApplicationCredential) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		identityClient, err := config.identityV3Client(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack identity client: %s", err)
+		}
+
+		userID, err := identityV3TokenUserID(identityClient, config.OsClient.TokenID)
+		if err != nil {
+			return err
+		}
+
+		found, err := applicationcredentials.Get(identityClient, userID, rs.Primary.ID).Extract()
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return fmt.Errorf("Application credential not found")
+		}
+
+		*applicationCredential = *found
+
+		return nil
+	}
+}
+
+const testAccIdentityV3ApplicationCredential_basic = `
+resource "openstack_identity_application_credential_v3" "app_cred_1" {
+  name        = "wrf"
+  description = "wrf Terraform application credential"
+  expires_at  = "2219-02-13T12:12:12Z"
+}
+`
+
+const testAccIdentityV3ApplicationCredential_custom_secret = `
+resource "openstack_identity_application_credential_v3" "app_cred_1" {
+  name         = "wrf"
+  description  = "wrf Terraform application credential"
+  unrestricted = true
+  secret       = "foo"
+}
+`
+
+const testAccIdentityV3ApplicationCredential_accessRules = `
+resource "openstack_identity_application_credential_v3" "app_cred_1" {
+  name = "monitoring"
+
+  access_rules {
+    path    = "/v2.0/metrics"
+    service = "monitoring"
+    method  = "GET"
+  }
+
+  access_rules {
+    path    = "/v2.0/metrics"
+    service = "monitoring"
+    method  = "PUT"
+  }
+}
+`
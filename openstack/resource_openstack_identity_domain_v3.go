@@ -0,0 +1,158 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/samuelbernardolip/gophercloud/openstack/identity/v3/domains"
+)
+
+func resourceIdentityDomainV3() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceIdentityDomainV3Create,
+		Read:   resourceIdentityDomainV3Read,
+		Update: resourceIdentityDomainV3Update,
+		Delete: resourceIdentityDomainV3Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"enabled": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceIdentityDomainV3Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	identityClient, err := config.identityV3Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack identity client: %s", err)
+	}
+
+	enabled := d.Get("enabled").(bool)
+	createOpts := domains.CreateOpts{
+		Description: d.Get("description").(string),
+		Enabled:     &enabled,
+		Name:        d.Get("name").(string),
+	}
+
+	log.Printf("[DEBUG] Create Options: %#v", createOpts)
+	domain, err := domains.Create(identityClient, createOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack domain: %s", err)
+	}
+
+	d.SetId(domain.ID)
+
+	return resourceIdentityDomainV3Read(d, meta)
+}
+
+func resourceIdentityDomainV3Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	identityClient, err := config.identityV3Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack identity client: %s", err)
+	}
+
+	domain, err := domains.Get(identityClient, d.Id()).Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "domain")
+	}
+
+	log.Printf("[DEBUG] Retrieved OpenStack domain: %#v", domain)
+
+	d.Set("description", domain.Description)
+	d.Set("enabled", domain.Enabled)
+	d.Set("name", domain.Name)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceIdentityDomainV3Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	identityClient, err := config.identityV3Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack identity client: %s", err)
+	}
+
+	var hasChange bool
+	var updateOpts domains.UpdateOpts
+
+	if d.HasChange("enabled") {
+		hasChange = true
+		enabled := d.Get("enabled").(bool)
+		updateOpts.Enabled = &enabled
+	}
+
+	if d.HasChange("name") {
+		hasChange = true
+		updateOpts.Name = d.Get("name").(string)
+	}
+
+	if d.HasChange("description") {
+		hasChange = true
+		description := d.Get("description").(string)
+		updateOpts.Description = &description
+	}
+
+	if hasChange {
+		_, err := domains.Update(identityClient, d.Id(), updateOpts).Extract()
+		if err != nil {
+			return fmt.Errorf("Error updating OpenStack domain: %s", err)
+		}
+	}
+
+	return resourceIdentityDomainV3Read(d, meta)
+}
+
+func resourceIdentityDomainV3Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	identityClient, err := config.identityV3Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack identity client: %s", err)
+	}
+
+	// Keystone refuses to delete an enabled domain, so disable it first.
+	// The domain may have been enabled outside of Terraform, so this is done
+	// regardless of the enabled argument.
+	enabled := false
+	updateOpts := domains.UpdateOpts{
+		Enabled: &enabled,
+	}
+
+	_, err = domains.Update(identityClient, d.Id(), updateOpts).Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "Error disabling OpenStack domain")
+	}
+
+	err = domains.Delete(identityClient, d.Id()).ExtractErr()
+	if err != nil {
+		return CheckDeleted(d, err, "Error deleting OpenStack domain")
+	}
+
+	return nil
+}
@@ -0,0 +1,124 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+
+	"github.com/samuelbernardolip/gophercloud/openstack/identity/v3/domains"
+)
+
+func TestAccIdentityV3Domain_basic(t *testing.T) {
+	var domain domains.Domain
+	var domainName = fmt.Sprintf("ACCPTTEST-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIdentityV3DomainDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccIdentityV3Domain_basic(domainName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIdentityV3DomainExists("openstack_identity_domain_v3.domain_1", &domain),
+					resource.TestCheckResourceAttrPtr(
+						"openstack_identity_domain_v3.domain_1", "name", &domain.Name),
+					resource.TestCheckResourceAttrPtr(
+						"openstack_identity_domain_v3.domain_1", "description", &domain.Description),
+					resource.TestCheckResourceAttr(
+						"openstack_identity_domain_v3.domain_1", "enabled", "true"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccIdentityV3Domain_update(domainName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIdentityV3DomainExists("openstack_identity_domain_v3.domain_1", &domain),
+					resource.TestCheckResourceAttrPtr(
+						"openstack_identity_domain_v3.domain_1", "name", &domain.Name),
+					resource.TestCheckResourceAttr(
+						"openstack_identity_domain_v3.domain_1", "description", "Some domain"),
+					resource.TestCheckResourceAttr(
+						"openstack_identity_domain_v3.domain_1", "enabled", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIdentityV3DomainDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	identityClient, err := config.identityV3Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack identity client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "openstack_identity_domain_v3" {
+			continue
+		}
+
+		_, err := domains.Get(identityClient, rs.Primary.ID).Extract()
+		if err == nil {
+			return fmt.Errorf("Domain still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckIdentityV3DomainExists(n string, domain *domains.Domain) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		identityClient, err := config.identityV3Client(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack identity client: %s", err)
+		}
+
+		found, err := domains.Get(identityClient, rs.Primary.ID).Extract()
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return fmt.Errorf("Domain not found")
+		}
+
+		*domain = *found
+
+		return nil
+	}
+}
+
+func testAccIdentityV3Domain_basic(domainName string) string {
+	return fmt.Sprintf(`
+    resource "openstack_identity_domain_v3" "domain_1" {
+      name = "%s"
+      description = "A domain"
+    }
+  `, domainName)
+}
+
+func testAccIdentityV3Domain_update(domainName string) string {
+	return fmt.Sprintf(`
+    resource "openstack_identity_domain_v3" "domain_1" {
+      name = "%s-updated"
+      description = "Some domain"
+      enabled = false
+    }
+  `, domainName)
+}
@@ -0,0 +1,183 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/samuelbernardolip/gophercloud"
+	"github.com/samuelbernardolip/gophercloud/openstack/identity/v3/endpoints"
+)
+
+func resourceIdentityEndpointV3() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceIdentityEndpointV3Create,
+		Read:   resourceIdentityEndpointV3Read,
+		Update: resourceIdentityEndpointV3Update,
+		Delete: resourceIdentityEndpointV3Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"endpoint_region": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"service_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"interface": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "public",
+				ValidateFunc: validation.StringInSlice([]string{
+					"public", "internal", "admin",
+				}, false),
+			},
+
+			"url": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+}
+
+func resourceIdentityEndpointV3Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	identityClient, err := config.identityV3Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack identity client: %s", err)
+	}
+
+	createOpts := IdentityEndpointV3CreateOpts{
+		Availability: identityEndpointV3Availability(d.Get("interface").(string)),
+		Name:         d.Get("name").(string),
+		Region:       d.Get("endpoint_region").(string),
+		URL:          d.Get("url").(string),
+		ServiceID:    d.Get("service_id").(string),
+	}
+
+	log.Printf("[DEBUG] Create Options: %#v", createOpts)
+	endpoint, err := endpoints.Create(identityClient, createOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack endpoint: %s", err)
+	}
+
+	d.SetId(endpoint.ID)
+
+	return resourceIdentityEndpointV3Read(d, meta)
+}
+
+func resourceIdentityEndpointV3Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	identityClient, err := config.identityV3Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack identity client: %s", err)
+	}
+
+	endpoint, err := identityEndpointV3Get(identityClient, d.Id())
+	if err != nil {
+		return CheckDeleted(d, err, "endpoint")
+	}
+
+	log.Printf("[DEBUG] Retrieved OpenStack endpoint: %#v", endpoint)
+
+	d.Set("name", endpoint.Name)
+	d.Set("endpoint_region", endpoint.Region)
+	d.Set("service_id", endpoint.ServiceID)
+	d.Set("interface", string(endpoint.Availability))
+	d.Set("url", endpoint.URL)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceIdentityEndpointV3Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	identityClient, err := config.identityV3Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack identity client: %s", err)
+	}
+
+	var hasChange bool
+	var updateOpts endpoints.UpdateOpts
+
+	if d.HasChange("name") {
+		hasChange = true
+		updateOpts.Name = d.Get("name").(string)
+	}
+
+	if d.HasChange("endpoint_region") {
+		hasChange = true
+		updateOpts.Region = d.Get("endpoint_region").(string)
+	}
+
+	if d.HasChange("service_id") {
+		hasChange = true
+		updateOpts.ServiceID = d.Get("service_id").(string)
+	}
+
+	if d.HasChange("interface") {
+		hasChange = true
+		updateOpts.Availability = identityEndpointV3Availability(d.Get("interface").(string))
+	}
+
+	if d.HasChange("url") {
+		hasChange = true
+		updateOpts.URL = d.Get("url").(string)
+	}
+
+	if hasChange {
+		_, err := endpoints.Update(identityClient, d.Id(), updateOpts).Extract()
+		if err != nil {
+			return fmt.Errorf("Error updating OpenStack endpoint: %s", err)
+		}
+	}
+
+	return resourceIdentityEndpointV3Read(d, meta)
+}
+
+func resourceIdentityEndpointV3Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	identityClient, err := config.identityV3Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack identity client: %s", err)
+	}
+
+	err = endpoints.Delete(identityClient, d.Id()).ExtractErr()
+	if err != nil {
+		return CheckDeleted(d, err, "Error deleting OpenStack endpoint")
+	}
+
+	return nil
+}
+
+func identityEndpointV3Availability(v string) gophercloud.Availability {
+	availability := gophercloud.AvailabilityPublic
+	switch v {
+	case "internal":
+		availability = gophercloud.AvailabilityInternal
+	case "admin":
+		availability = gophercloud.AvailabilityAdmin
+	}
+
+	return availability
+}
@@ -0,0 +1,150 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+
+	"github.com/samuelbernardolip/gophercloud/openstack/identity/v3/endpoints"
+)
+
+func TestAccIdentityV3Endpoint_basic(t *testing.T) {
+	var endpoint endpoints.Endpoint
+	var endpointName = fmt.Sprintf("ACCPTTEST-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIdentityV3EndpointDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccIdentityV3Endpoint_basic(endpointName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIdentityV3EndpointExists("openstack_identity_endpoint_v3.endpoint_1", &endpoint),
+					resource.TestCheckResourceAttrPtr(
+						"openstack_identity_endpoint_v3.endpoint_1", "name", &endpoint.Name),
+					resource.TestCheckResourceAttrPtr(
+						"openstack_identity_endpoint_v3.endpoint_1", "url", &endpoint.URL),
+					resource.TestCheckResourceAttrPair(
+						"openstack_identity_endpoint_v3.endpoint_1", "service_id",
+						"openstack_identity_service_v3.service_1", "id"),
+					resource.TestCheckResourceAttrPair(
+						"openstack_identity_endpoint_v3.endpoint_1", "endpoint_region",
+						"openstack_identity_region_v3.region_1", "id"),
+					resource.TestCheckResourceAttr(
+						"openstack_identity_endpoint_v3.endpoint_1", "interface", "public"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccIdentityV3Endpoint_update(endpointName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIdentityV3EndpointExists("openstack_identity_endpoint_v3.endpoint_1", &endpoint),
+					resource.TestCheckResourceAttr(
+						"openstack_identity_endpoint_v3.endpoint_1", "url", "http://my-new-endpoint"),
+					resource.TestCheckResourceAttr(
+						"openstack_identity_endpoint_v3.endpoint_1", "interface", "internal"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIdentityV3EndpointDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	identityClient, err := config.identityV3Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack identity client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "openstack_identity_endpoint_v3" {
+			continue
+		}
+
+		_, err := identityEndpointV3Get(identityClient, rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("Endpoint still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckIdentityV3EndpointExists(n string, endpoint *endpoints.Endpoint) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		identityClient, err := config.identityV3Client(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack identity client: %s", err)
+		}
+
+		found, err := identityEndpointV3Get(identityClient, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return fmt.Errorf("Endpoint not found")
+		}
+
+		*endpoint = *found
+
+		return nil
+	}
+}
+
+func testAccIdentityV3Endpoint_basic(endpointName string) string {
+	return fmt.Sprintf(`
+    resource "openstack_identity_region_v3" "region_1" {
+      region_id = "%s"
+    }
+
+    resource "openstack_identity_service_v3" "service_1" {
+      name = "%s"
+      type = "%s"
+    }
+
+    resource "openstack_identity_endpoint_v3" "endpoint_1" {
+      name = "%s"
+      service_id = "${openstack_identity_service_v3.service_1.id}"
+      endpoint_region = "${openstack_identity_region_v3.region_1.id}"
+      url = "http://my-endpoint"
+    }
+  `, endpointName, endpointName, endpointName, endpointName)
+}
+
+func testAccIdentityV3Endpoint_update(endpointName string) string {
+	return fmt.Sprintf(`
+    resource "openstack_identity_region_v3" "region_1" {
+      region_id = "%s"
+    }
+
+    resource "openstack_identity_service_v3" "service_1" {
+      name = "%s"
+      type = "%s"
+    }
+
+    resource "openstack_identity_endpoint_v3" "endpoint_1" {
+      name = "%s"
+      service_id = "${openstack_identity_service_v3.service_1.id}"
+      endpoint_region = "${openstack_identity_region_v3.region_1.id}"
+      interface = "internal"
+      url = "http://my-new-endpoint"
+    }
+  `, endpointName, endpointName, endpointName, endpointName)
+}
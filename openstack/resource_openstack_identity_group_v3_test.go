@@ -0,0 +1,123 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+
+	"github.com/samuelbernardolip/gophercloud/openstack/identity/v3/groups"
+)
+
+func TestAccIdentityV3Group_basic(t *testing.T) {
+	var group groups.Group
+	var groupName = fmt.Sprintf("ACCPTTEST-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIdentityV3GroupDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccIdentityV3Group_basic(groupName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIdentityV3GroupExists("openstack_identity_group_v3.group_1", &group),
+					resource.TestCheckResourceAttrPtr(
+						"openstack_identity_group_v3.group_1", "name", &group.Name),
+					resource.TestCheckResourceAttrPtr(
+						"openstack_identity_group_v3.group_1", "description", &group.Description),
+					resource.TestCheckResourceAttr(
+						"openstack_identity_group_v3.group_1", "domain_id", "default"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccIdentityV3Group_update(groupName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIdentityV3GroupExists("openstack_identity_group_v3.group_1", &group),
+					resource.TestCheckResourceAttrPtr(
+						"openstack_identity_group_v3.group_1", "name", &group.Name),
+					resource.TestCheckResourceAttr(
+						"openstack_identity_group_v3.group_1", "description", "Some group"),
+					resource.TestCheckResourceAttr(
+						"openstack_identity_group_v3.group_1", "domain_id", "default"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIdentityV3GroupDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	identityClient, err := config.identityV3Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack identity client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "openstack_identity_group_v3" {
+			continue
+		}
+
+		_, err := groups.Get(identityClient, rs.Primary.ID).Extract()
+		if err == nil {
+			return fmt.Errorf("Group still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckIdentityV3GroupExists(n string, group *groups.Group) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		identityClient, err := config.identityV3Client(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack identity client: %s", err)
+		}
+
+		found, err := groups.Get(identityClient, rs.Primary.ID).Extract()
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return fmt.Errorf("Group not found")
+		}
+
+		*group = *found
+
+		return nil
+	}
+}
+
+func testAccIdentityV3Group_basic(groupName string) string {
+	return fmt.Sprintf(`
+    resource "openstack_identity_group_v3" "group_1" {
+      name = "%s"
+      description = "A group"
+    }
+  `, groupName)
+}
+
+func testAccIdentityV3Group_update(groupName string) string {
+	return fmt.Sprintf(`
+    resource "openstack_identity_group_v3" "group_1" {
+      name = "%s-updated"
+      description = "Some group"
+    }
+  `, groupName)
+}
@@ -0,0 +1,131 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+
+	"github.com/samuelbernardolip/gophercloud/openstack/identity/v3/regions"
+)
+
+func TestAccIdentityV3Region_basic(t *testing.T) {
+	var region regions.Region
+	var regionID = fmt.Sprintf("ACCPTTEST-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIdentityV3RegionDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccIdentityV3Region_basic(regionID),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIdentityV3RegionExists("openstack_identity_region_v3.region_1", &region),
+					resource.TestCheckResourceAttr(
+						"openstack_identity_region_v3.region_1", "region_id", regionID),
+					resource.TestCheckResourceAttrPtr(
+						"openstack_identity_region_v3.region_1", "description", &region.Description),
+					resource.TestCheckResourceAttr(
+						"openstack_identity_region_v3.region_1", "parent_region_id", ""),
+					resource.TestCheckResourceAttr(
+						"openstack_identity_region_v3.region_2", "parent_region_id", regionID),
+				),
+			},
+			resource.TestStep{
+				Config: testAccIdentityV3Region_update(regionID),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIdentityV3RegionExists("openstack_identity_region_v3.region_1", &region),
+					resource.TestCheckResourceAttr(
+						"openstack_identity_region_v3.region_1", "description", "Some region"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIdentityV3RegionDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	identityClient, err := config.identityV3Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack identity client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "openstack_identity_region_v3" {
+			continue
+		}
+
+		_, err := regions.Get(identityClient, rs.Primary.ID).Extract()
+		if err == nil {
+			return fmt.Errorf("Region still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckIdentityV3RegionExists(n string, region *regions.Region) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		identityClient, err := config.identityV3Client(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack identity client: %s", err)
+		}
+
+		found, err := regions.Get(identityClient, rs.Primary.ID).Extract()
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return fmt.Errorf("Region not found")
+		}
+
+		*region = *found
+
+		return nil
+	}
+}
+
+func testAccIdentityV3Region_basic(regionID string) string {
+	return fmt.Sprintf(`
+    resource "openstack_identity_region_v3" "region_1" {
+      region_id = "%s"
+      description = "A region"
+    }
+
+    resource "openstack_identity_region_v3" "region_2" {
+      region_id = "%s-child"
+      parent_region_id = "${openstack_identity_region_v3.region_1.id}"
+    }
+  `, regionID, regionID)
+}
+
+func testAccIdentityV3Region_update(regionID string) string {
+	return fmt.Sprintf(`
+    resource "openstack_identity_region_v3" "region_1" {
+      region_id = "%s"
+      description = "Some region"
+    }
+
+    resource "openstack_identity_region_v3" "region_2" {
+      region_id = "%s-child"
+      parent_region_id = "${openstack_identity_region_v3.region_1.id}"
+    }
+  `, regionID, regionID)
+}
@@ -0,0 +1,167 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/samuelbernardolip/gophercloud/openstack/identity/v3/services"
+)
+
+func resourceIdentityServiceV3() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceIdentityServiceV3Create,
+		Read:   resourceIdentityServiceV3Read,
+		Update: resourceIdentityServiceV3Update,
+		Delete: resourceIdentityServiceV3Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"type": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"enabled": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+		},
+	}
+}
+
+func resourceIdentityServiceV3Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	identityClient, err := config.identityV3Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack identity client: %s", err)
+	}
+
+	enabled := d.Get("enabled").(bool)
+	createOpts := services.CreateOpts{
+		Type:    d.Get("type").(string),
+		Enabled: &enabled,
+		Extra: map[string]interface{}{
+			"name":        d.Get("name").(string),
+			"description": d.Get("description").(string),
+		},
+	}
+
+	log.Printf("[DEBUG] Create Options: %#v", createOpts)
+	service, err := services.Create(identityClient, createOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack service: %s", err)
+	}
+
+	d.SetId(service.ID)
+
+	return resourceIdentityServiceV3Read(d, meta)
+}
+
+func resourceIdentityServiceV3Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	identityClient, err := config.identityV3Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack identity client: %s", err)
+	}
+
+	service, err := services.Get(identityClient, d.Id()).Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "service")
+	}
+
+	log.Printf("[DEBUG] Retrieved OpenStack service: %#v", service)
+
+	d.Set("type", service.Type)
+	d.Set("enabled", service.Enabled)
+	d.Set("region", GetRegion(d, config))
+
+	// The name and the description of a service are returned as
+	// free-form extra attributes.
+	if v, ok := service.Extra["name"].(string); ok {
+		d.Set("name", v)
+	}
+	if v, ok := service.Extra["description"].(string); ok {
+		d.Set("description", v)
+	}
+
+	return nil
+}
+
+func resourceIdentityServiceV3Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	identityClient, err := config.identityV3Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack identity client: %s", err)
+	}
+
+	var hasChange bool
+	updateOpts := services.UpdateOpts{
+		Type:  d.Get("type").(string),
+		Extra: make(map[string]interface{}),
+	}
+
+	if d.HasChange("type") {
+		hasChange = true
+	}
+
+	if d.HasChange("enabled") {
+		hasChange = true
+		enabled := d.Get("enabled").(bool)
+		updateOpts.Enabled = &enabled
+	}
+
+	if d.HasChange("name") {
+		hasChange = true
+		updateOpts.Extra["name"] = d.Get("name").(string)
+	}
+
+	if d.HasChange("description") {
+		hasChange = true
+		updateOpts.Extra["description"] = d.Get("description").(string)
+	}
+
+	if hasChange {
+		_, err := services.Update(identityClient, d.Id(), updateOpts).Extract()
+		if err != nil {
+			return fmt.Errorf("Error updating OpenStack service: %s", err)
+		}
+	}
+
+	return resourceIdentityServiceV3Read(d, meta)
+}
+
+func resourceIdentityServiceV3Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	identityClient, err := config.identityV3Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack identity client: %s", err)
+	}
+
+	err = services.Delete(identityClient, d.Id()).ExtractErr()
+	if err != nil {
+		return CheckDeleted(d, err, "Error deleting OpenStack service")
+	}
+
+	return nil
+}
@@ -0,0 +1,130 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+
+	"github.com/samuelbernardolip/gophercloud/openstack/identity/v3/services"
+)
+
+func TestAccIdentityV3Service_basic(t *testing.T) {
+	var service services.Service
+	var serviceName = fmt.Sprintf("ACCPTTEST-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIdentityV3ServiceDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccIdentityV3Service_basic(serviceName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIdentityV3ServiceExists("openstack_identity_service_v3.service_1", &service),
+					resource.TestCheckResourceAttrPtr(
+						"openstack_identity_service_v3.service_1", "type", &service.Type),
+					resource.TestCheckResourceAttr(
+						"openstack_identity_service_v3.service_1", "name", serviceName),
+					resource.TestCheckResourceAttr(
+						"openstack_identity_service_v3.service_1", "description", "A service"),
+					resource.TestCheckResourceAttr(
+						"openstack_identity_service_v3.service_1", "enabled", "true"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccIdentityV3Service_update(serviceName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIdentityV3ServiceExists("openstack_identity_service_v3.service_1", &service),
+					resource.TestCheckResourceAttrPtr(
+						"openstack_identity_service_v3.service_1", "type", &service.Type),
+					resource.TestCheckResourceAttr(
+						"openstack_identity_service_v3.service_1", "name", serviceName+"-updated"),
+					resource.TestCheckResourceAttr(
+						"openstack_identity_service_v3.service_1", "description", "Some service"),
+					resource.TestCheckResourceAttr(
+						"openstack_identity_service_v3.service_1", "enabled", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIdentityV3ServiceDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	identityClient, err := config.identityV3Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack identity client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "openstack_identity_service_v3" {
+			continue
+		}
+
+		_, err := services.Get(identityClient, rs.Primary.ID).Extract()
+		if err == nil {
+			return fmt.Errorf("Service still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckIdentityV3ServiceExists(n string, service *services.Service) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		identityClient, err := config.identityV3Client(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack identity client: %s", err)
+		}
+
+		found, err := services.Get(identityClient, rs.Primary.ID).Extract()
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return fmt.Errorf("Service not found")
+		}
+
+		*service = *found
+
+		return nil
+	}
+}
+
+func testAccIdentityV3Service_basic(serviceName string) string {
+	return fmt.Sprintf(`
+    resource "openstack_identity_service_v3" "service_1" {
+      name = "%s"
+      type = "%s"
+      description = "A service"
+    }
+  `, serviceName, serviceName)
+}
+
+func testAccIdentityV3Service_update(serviceName string) string {
+	return fmt.Sprintf(`
+    resource "openstack_identity_service_v3" "service_1" {
+      name = "%s-updated"
+      type = "%s"
+      description = "Some service"
+      enabled = false
+    }
+  `, serviceName, serviceName)
+}
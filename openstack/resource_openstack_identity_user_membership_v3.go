@@ -0,0 +1,127 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/samuelbernardolip/gophercloud/openstack/identity/v3/users"
+)
+
+func resourceIdentityUserMembershipV3() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceIdentityUserMembershipV3Create,
+		Read:   resourceIdentityUserMembershipV3Read,
+		Delete: resourceIdentityUserMembershipV3Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"user_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"group_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceIdentityUserMembershipV3Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	identityClient, err := config.identityV3Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack identity client: %s", err)
+	}
+
+	userID := d.Get("user_id").(string)
+	groupID := d.Get("group_id").(string)
+
+	log.Printf("[DEBUG] Adding OpenStack user %s to group %s", userID, groupID)
+	err = users.AddToGroup(identityClient, groupID, userID).ExtractErr()
+	if err != nil {
+		return fmt.Errorf("Error adding OpenStack user %s to group %s: %s", userID, groupID, err)
+	}
+
+	d.SetId(buildUserMembershipID(userID, groupID))
+
+	return resourceIdentityUserMembershipV3Read(d, meta)
+}
+
+func resourceIdentityUserMembershipV3Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	identityClient, err := config.identityV3Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack identity client: %s", err)
+	}
+
+	userID, groupID, err := extractUserMembershipID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	isMember, err := users.IsMemberOfGroup(identityClient, groupID, userID).Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "user membership")
+	}
+
+	if !isMember {
+		log.Printf("[DEBUG] OpenStack user %s is not a member of group %s", userID, groupID)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("user_id", userID)
+	d.Set("group_id", groupID)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceIdentityUserMembershipV3Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	identityClient, err := config.identityV3Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack identity client: %s", err)
+	}
+
+	userID, groupID, err := extractUserMembershipID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	err = users.RemoveFromGroup(identityClient, groupID, userID).ExtractErr()
+	if err != nil {
+		return CheckDeleted(d, err, "Error removing OpenStack user from group")
+	}
+
+	return nil
+}
+
+// User memberships have no ID in OpenStack. Build an ID out of the user and group IDs.
+func buildUserMembershipID(userID, groupID string) string {
+	return fmt.Sprintf("%s/%s", userID, groupID)
+}
+
+func extractUserMembershipID(userMembershipID string) (string, string, error) {
+	split := strings.Split(userMembershipID, "/")
+	if len(split) != 2 || split[0] == "" || split[1] == "" {
+		return "", "", fmt.Errorf("Invalid format specified for openstack_identity_user_membership_v3. Format must be <user_id>/<group_id>")
+	}
+
+	return split[0], split[1], nil
+}
@@ -0,0 +1,119 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+
+	"github.com/samuelbernardolip/gophercloud/openstack/identity/v3/users"
+)
+
+func TestAccIdentityV3UserMembership_basic(t *testing.T) {
+	var groupName = fmt.Sprintf("ACCPTTEST-%s", acctest.RandString(5))
+	var userName = fmt.Sprintf("ACCPTTEST-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIdentityV3UserMembershipDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccIdentityV3UserMembership_basic(groupName, userName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIdentityV3UserMembershipExists("openstack_identity_user_membership_v3.user_membership_1"),
+					resource.TestCheckResourceAttrPair(
+						"openstack_identity_user_membership_v3.user_membership_1", "user_id",
+						"openstack_identity_user_v3.user_1", "id"),
+					resource.TestCheckResourceAttrPair(
+						"openstack_identity_user_membership_v3.user_membership_1", "group_id",
+						"openstack_identity_group_v3.group_1", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIdentityV3UserMembershipDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	identityClient, err := config.identityV3Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack identity client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "openstack_identity_user_membership_v3" {
+			continue
+		}
+
+		userID, groupID, err := extractUserMembershipID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		isMember, err := users.IsMemberOfGroup(identityClient, groupID, userID).Extract()
+		if err == nil && isMember {
+			return fmt.Errorf("User membership still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckIdentityV3UserMembershipExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		identityClient, err := config.identityV3Client(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack identity client: %s", err)
+		}
+
+		userID, groupID, err := extractUserMembershipID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		isMember, err := users.IsMemberOfGroup(identityClient, groupID, userID).Extract()
+		if err != nil {
+			return err
+		}
+
+		if !isMember {
+			return fmt.Errorf("User membership not found")
+		}
+
+		return nil
+	}
+}
+
+func testAccIdentityV3UserMembership_basic(groupName, userName string) string {
+	return fmt.Sprintf(`
+    resource "openstack_identity_group_v3" "group_1" {
+      name = "%s"
+    }
+
+    resource "openstack_identity_user_v3" "user_1" {
+      name = "%s"
+      password = "password123"
+    }
+
+    resource "openstack_identity_user_membership_v3" "user_membership_1" {
+      user_id = "${openstack_identity_user_v3.user_1.id}"
+      group_id = "${openstack_identity_group_v3.group_1.id}"
+    }
+  `, groupName, userName)
+}
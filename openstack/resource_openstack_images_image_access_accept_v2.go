@@ -0,0 +1,187 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/samuelbernardolip/gophercloud/openstack/imageservice/v2/members"
+)
+
+func resourceImagesImageAccessAcceptV2() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceImagesImageAccessAcceptV2Create,
+		Read:   resourceImagesImageAccessAcceptV2Read,
+		Update: resourceImagesImageAccessAcceptV2Update,
+		Delete: resourceImagesImageAccessAcceptV2Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"image_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"member_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"status": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"accepted", "rejected", "pending",
+				}, false),
+			},
+
+			"created_at": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"updated_at": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"schema": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceImagesImageAccessAcceptV2Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	imageClient, err := config.imageV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack image client: %s", err)
+	}
+
+	imageID := d.Get("image_id").(string)
+	memberID := d.Get("member_id").(string)
+	if memberID == "" {
+		memberID, err = imagesImageAccessV2CurrentProject(config, GetRegion(d, config))
+		if err != nil {
+			return err
+		}
+	}
+
+	// The member has to be added by the owner of the image first.
+	if _, err := members.Get(imageClient, imageID, memberID).Extract(); err != nil {
+		return fmt.Errorf("Error retrieving member %s of image %s: %s", memberID, imageID, err)
+	}
+
+	updateOpts := members.UpdateOpts{
+		Status: d.Get("status").(string),
+	}
+
+	log.Printf("[DEBUG] Update Options: %#v", updateOpts)
+	member, err := members.Update(imageClient, imageID, memberID, updateOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error setting status of member %s of image %s: %s", memberID, imageID, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", member.ImageID, member.MemberID))
+
+	return resourceImagesImageAccessAcceptV2Read(d, meta)
+}
+
+func resourceImagesImageAccessAcceptV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	imageClient, err := config.imageV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack image client: %s", err)
+	}
+
+	imageID, memberID, err := parseImagesImageAccessID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	member, err := members.Get(imageClient, imageID, memberID).Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "image access accept")
+	}
+
+	log.Printf("[DEBUG] Retrieved image member %s: %#v", d.Id(), member)
+
+	d.Set("image_id", member.ImageID)
+	d.Set("member_id", member.MemberID)
+	d.Set("status", member.Status)
+	d.Set("created_at", member.CreatedAt.Format(time.RFC3339))
+	d.Set("updated_at", member.UpdatedAt.Format(time.RFC3339))
+	d.Set("schema", member.Schema)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceImagesImageAccessAcceptV2Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	imageClient, err := config.imageV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack image client: %s", err)
+	}
+
+	imageID, memberID, err := parseImagesImageAccessID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if d.HasChange("status") {
+		updateOpts := members.UpdateOpts{
+			Status: d.Get("status").(string),
+		}
+
+		log.Printf("[DEBUG] Update Options: %#v", updateOpts)
+		_, err = members.Update(imageClient, imageID, memberID, updateOpts).Extract()
+		if err != nil {
+			return fmt.Errorf("Error setting status of member %s of image %s: %s", memberID, imageID, err)
+		}
+	}
+
+	return resourceImagesImageAccessAcceptV2Read(d, meta)
+}
+
+func resourceImagesImageAccessAcceptV2Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	imageClient, err := config.imageV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack image client: %s", err)
+	}
+
+	imageID, memberID, err := parseImagesImageAccessID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	// Only the owner can remove a member, so the image is rejected instead.
+	updateOpts := members.UpdateOpts{
+		Status: "rejected",
+	}
+
+	log.Printf("[DEBUG] Rejecting image %s for member %s", imageID, memberID)
+	_, err = members.Update(imageClient, imageID, memberID, updateOpts).Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "image access accept")
+	}
+
+	return nil
+}
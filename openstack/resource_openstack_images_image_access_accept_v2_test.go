@@ -0,0 +1,72 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/samuelbernardolip/gophercloud/openstack/imageservice/v2/members"
+)
+
+func TestAccImagesImageAccessAcceptV2_basic(t *testing.T) {
+	var member members.Member
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckImagesImageAccessV2Destroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccImagesImageAccessAcceptV2_basic("accepted"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckImagesImageAccessV2Exists("openstack_images_image_access_accept_v2.accept_1", &member),
+					resource.TestCheckResourceAttrPair(
+						"openstack_images_image_access_accept_v2.accept_1", "member_id",
+						"data.openstack_identity_auth_scope_v3.scope", "project_id"),
+					resource.TestCheckResourceAttr(
+						"openstack_images_image_access_accept_v2.accept_1", "status", "accepted"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccImagesImageAccessAcceptV2_basic("rejected"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckImagesImageAccessV2Exists("openstack_images_image_access_accept_v2.accept_1", &member),
+					resource.TestCheckResourceAttr(
+						"openstack_images_image_access_accept_v2.accept_1", "status", "rejected"),
+				),
+			},
+		},
+	})
+}
+
+// The image is shared with the project of the provider, so that the same
+// credentials can act both as the owner and as the consumer.
+func testAccImagesImageAccessAcceptV2_basic(status string) string {
+	return fmt.Sprintf(`
+    resource "openstack_images_image_v2" "image_1" {
+      name   = "Rancher TerraformAccTest"
+      image_source_url = "https://releases.rancher.com/os/latest/rancheros-openstack.img"
+      container_format = "bare"
+      disk_format = "qcow2"
+      visibility = "shared"
+
+      timeouts {
+        create = "10m"
+      }
+    }
+
+    data "openstack_identity_auth_scope_v3" "scope" {
+      name = "scope"
+    }
+
+    resource "openstack_images_image_access_v2" "access_1" {
+      image_id  = "${openstack_images_image_v2.image_1.id}"
+      member_id = "${data.openstack_identity_auth_scope_v3.scope.project_id}"
+    }
+
+    resource "openstack_images_image_access_accept_v2" "accept_1" {
+      image_id = "${openstack_images_image_access_v2.access_1.image_id}"
+      status   = "%s"
+    }
+    `, status)
+}
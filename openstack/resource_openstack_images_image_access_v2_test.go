@@ -0,0 +1,133 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/samuelbernardolip/gophercloud/openstack/identity/v3/projects"
+	"github.com/samuelbernardolip/gophercloud/openstack/imageservice/v2/images"
+	"github.com/samuelbernardolip/gophercloud/openstack/imageservice/v2/members"
+)
+
+func TestAccImagesImageAccessV2_basic(t *testing.T) {
+	var image images.Image
+	var project projects.Project
+	var member members.Member
+	projectName := fmt.Sprintf("ACCPTTEST-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckImagesImageAccessV2Destroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccImagesImageAccessV2_basic(projectName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckImagesImageV2Exists("openstack_images_image_v2.image_1", &image),
+					testAccCheckIdentityV3ProjectExists("openstack_identity_project_v3.project_1", &project),
+					testAccCheckImagesImageAccessV2Exists("openstack_images_image_access_v2.access_1", &member),
+					resource.TestCheckResourceAttrPtr(
+						"openstack_images_image_access_v2.access_1", "image_id", &image.ID),
+					resource.TestCheckResourceAttrPtr(
+						"openstack_images_image_access_v2.access_1", "member_id", &project.ID),
+					resource.TestCheckResourceAttr(
+						"openstack_images_image_access_v2.access_1", "status", "pending"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckImagesImageAccessV2Destroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	imageClient, err := config.imageV2Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack image client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "openstack_images_image_access_v2" {
+			continue
+		}
+
+		imageID, memberID, err := parseImagesImageAccessID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		_, err = members.Get(imageClient, imageID, memberID).Extract()
+		if err == nil {
+			return fmt.Errorf("Image access still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckImagesImageAccessV2Exists(n string, member *members.Member) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		imageClient, err := config.imageV2Client(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack image client: %s", err)
+		}
+
+		imageID, memberID, err := parseImagesImageAccessID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		found, err := members.Get(imageClient, imageID, memberID).Extract()
+		if err != nil {
+			return err
+		}
+
+		if found.ImageID != imageID || found.MemberID != memberID {
+			return fmt.Errorf("Image access not found")
+		}
+
+		*member = *found
+
+		return nil
+	}
+}
+
+func testAccImagesImageAccessV2_basic(projectName string) string {
+	return fmt.Sprintf(`
+    resource "openstack_images_image_v2" "image_1" {
+      name   = "Rancher TerraformAccTest"
+      image_source_url = "https://releases.rancher.com/os/latest/rancheros-openstack.img"
+      container_format = "bare"
+      disk_format = "qcow2"
+      visibility = "shared"
+
+      timeouts {
+        create = "10m"
+      }
+    }
+
+    resource "openstack_identity_project_v3" "project_1" {
+      name = "%s"
+    }
+
+    resource "openstack_images_image_access_v2" "access_1" {
+      image_id  = "${openstack_images_image_v2.image_1.id}"
+      member_id = "${openstack_identity_project_v3.project_1.id}"
+    }
+    `, projectName)
+}
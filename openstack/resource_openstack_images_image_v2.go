@@ -2,22 +2,24 @@ package openstack
 
 import (
 	"crypto/md5"
-	"encoding/hex"
 	"fmt"
 	"io"
 	"log"
 	"net/http"
 	"os"
 	"path/filepath"
+	"regexp"
 	"strings"
 	"time"
 
 	"github.com/samuelbernardolip/gophercloud"
 	"github.com/samuelbernardolip/gophercloud/openstack/imageservice/v2/imagedata"
+	"github.com/samuelbernardolip/gophercloud/openstack/imageservice/v2/imageimport"
 	"github.com/samuelbernardolip/gophercloud/openstack/imageservice/v2/images"
 
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 )
 
 func resourceImagesImageV2() *schema.Resource {
@@ -34,6 +36,7 @@ func resourceImagesImageV2() *schema.Resource {
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -76,6 +79,15 @@ func resourceImagesImageV2() *schema.Resource {
 				ConflictsWith: []string{"local_file_path"},
 			},
 
+			"image_source_sha256": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"local_file_path"},
+				ValidateFunc: validation.StringMatch(
+					regexp.MustCompile("^[0-9a-fA-F]{64}$"), "must be a hex encoded SHA-256"),
+			},
+
 			"local_file_path": &schema.Schema{
 				Type:          schema.TypeString,
 				Optional:      true,
@@ -83,6 +95,23 @@ func resourceImagesImageV2() *schema.Resource {
 				ConflictsWith: []string{"image_source_url"},
 			},
 
+			"import_method": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(imageimport.WebDownloadMethod), string(imageimport.GlanceDirectMethod),
+				}, false),
+			},
+
+			"stores": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+
 			"min_disk_gb": &schema.Schema{
 				Type:         schema.TypeInt,
 				Optional:     true,
@@ -146,6 +175,16 @@ func resourceImagesImageV2() *schema.Resource {
 				Computed: true,
 			},
 
+			"os_hash_algo": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"os_hash_value": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
 			"created_at": &schema.Schema{
 				Type:     schema.TypeString,
 				Computed: true,
@@ -197,6 +236,17 @@ func resourceImagesImageV2Create(d *schema.ResourceData, meta interface{}) error
 	properties := d.Get("properties").(map[string]interface{})
 	imageProperties := resourceImagesImageV2ExpandProperties(properties)
 
+	importMethod := imageimport.ImportMethod(d.Get("import_method").(string))
+	stores := resourceImagesImageV2BuildTags(d.Get("stores").(*schema.Set).List())
+
+	if importMethod == "" && len(stores) > 0 {
+		return fmt.Errorf("Error in config. stores can only be set with import_method")
+	}
+
+	if importMethod == imageimport.WebDownloadMethod && d.Get("image_source_url").(string) == "" {
+		return fmt.Errorf("Error in config. image_source_url is required with the %s import_method", importMethod)
+	}
+
 	createOpts := &images.CreateOpts{
 		Name:            d.Get("name").(string),
 		ContainerFormat: d.Get("container_format").(string),
@@ -213,6 +263,16 @@ func resourceImagesImageV2Create(d *schema.ResourceData, meta interface{}) error
 		createOpts.Tags = resourceImagesImageV2BuildTags(tags)
 	}
 
+	// The image file is downloaded and verified before the image is
+	// created, so that no image is created from a file which doesn't match.
+	var imgFilePath string
+	if importMethod != imageimport.WebDownloadMethod {
+		imgFilePath, err = resourceImagesImageV2File(d)
+		if err != nil {
+			return fmt.Errorf("Error opening file for Image: %s", err)
+		}
+	}
+
 	d.Partial(true)
 
 	log.Printf("[DEBUG] Create Options: %#v", createOpts)
@@ -223,35 +283,81 @@ func resourceImagesImageV2Create(d *schema.ResourceData, meta interface{}) error
 
 	d.SetId(newImg.ID)
 
-	// downloading/getting image file props
-	imgFilePath, err := resourceImagesImageV2File(d)
-	if err != nil {
-		return fmt.Errorf("Error opening file for Image: %s", err)
+	// hashes is only set when the image data is read by Terraform.
+	var hashes imagesImageV2Hashes
+	refreshFunc := resourceImagesImageV2RefreshFunc(imageClient, d.Id())
 
-	}
-	fileSize, fileChecksum, err := resourceImagesImageV2FileProps(imgFilePath)
-	if err != nil {
-		return fmt.Errorf("Error getting file props: %s", err)
-	}
+	if importMethod == imageimport.WebDownloadMethod {
+		// The image is downloaded by the Image service itself.
+		importOpts := imageimport.CreateOpts{
+			Name:   importMethod,
+			URI:    d.Get("image_source_url").(string),
+			Stores: stores,
+		}
 
-	// upload
-	imgFile, err := os.Open(imgFilePath)
-	if err != nil {
-		return fmt.Errorf("Error opening file %q: %s", imgFilePath, err)
-	}
-	defer imgFile.Close()
-	log.Printf("[WARN] Uploading image %s (%d bytes). This can be pretty long.", d.Id(), fileSize)
+		log.Printf("[DEBUG] Import Options: %#v", importOpts)
+		if err := imageimport.Create(imageClient, d.Id(), importOpts).ExtractErr(); err != nil {
+			return fmt.Errorf("Error importing Image: %s", err)
+		}
+
+		refreshFunc = imagesImageV2ImportRefreshFunc(imageClient, d.Id())
+	} else {
+		// upload
+		imgFile, err := os.Open(imgFilePath)
+		if err != nil {
+			return fmt.Errorf("Error opening file %q: %s", imgFilePath, err)
+		}
+		defer imgFile.Close()
+
+		fstat, err := imgFile.Stat()
+		if err != nil {
+			return fmt.Errorf("Error reading image file %q: %s", imgFilePath, err)
+		}
+		fileSize := fstat.Size()
+
+		// The file is hashed while it is sent to the Image service.
+		hashes = newImagesImageV2Hashes()
+		imgData := hashes.Reader(imgFile)
+
+		if importMethod == imageimport.GlanceDirectMethod {
+			log.Printf("[WARN] Staging image %s (%d bytes). This can be pretty long.", d.Id(), fileSize)
+
+			res := imagedata.Stage(imageClient, d.Id(), imgData)
+			if res.Err != nil {
+				return fmt.Errorf("Error while staging file %q: %s", imgFilePath, res.Err)
+			}
+
+			importOpts := imageimport.CreateOpts{
+				Name:   importMethod,
+				Stores: stores,
+			}
 
-	res := imagedata.Upload(imageClient, d.Id(), imgFile)
-	if res.Err != nil {
-		return fmt.Errorf("Error while uploading file %q: %s", imgFilePath, res.Err)
+			log.Printf("[DEBUG] Import Options: %#v", importOpts)
+			if err := imageimport.Create(imageClient, d.Id(), importOpts).ExtractErr(); err != nil {
+				return fmt.Errorf("Error importing Image: %s", err)
+			}
+
+			refreshFunc = imagesImageV2ImportRefreshFunc(imageClient, d.Id())
+		} else {
+			log.Printf("[WARN] Uploading image %s (%d bytes). This can be pretty long.", d.Id(), fileSize)
+
+			res := imagedata.Upload(imageClient, d.Id(), imgData)
+			if res.Err != nil {
+				return fmt.Errorf("Error while uploading file %q: %s", imgFilePath, res.Err)
+			}
+		}
 	}
 
 	//wait for active
 	stateConf := &resource.StateChangeConf{
-		Pending:    []string{string(images.ImageStatusQueued), string(images.ImageStatusSaving)},
+		Pending: []string{
+			string(images.ImageStatusQueued),
+			string(images.ImageStatusSaving),
+			string(imagesImageV2StatusUploading),
+			string(imagesImageV2StatusImporting),
+		},
 		Target:     []string{string(images.ImageStatusActive)},
-		Refresh:    resourceImagesImageV2RefreshFunc(imageClient, d.Id()),
+		Refresh:    refreshFunc,
 		Timeout:    d.Timeout(schema.TimeoutCreate),
 		Delay:      10 * time.Second,
 		MinTimeout: 3 * time.Second,
@@ -266,9 +372,20 @@ func resourceImagesImageV2Create(d *schema.ResourceData, meta interface{}) error
 		return CheckDeleted(d, err, "image")
 	}
 
-	verifyChecksum := d.Get("verify_checksum").(bool)
-	if img.Checksum != fileChecksum && verifyChecksum {
-		return fmt.Errorf("Error wrong checksum: got %q, expected %q", img.Checksum, fileChecksum)
+	expectedSHA256 := d.Get("image_source_sha256").(string)
+	if hashes != nil {
+		if expectedSHA256 != "" {
+			sum, _ := hashes.Sum("sha256")
+			if err := imagesImageV2VerifySHA256(expectedSHA256, sum); err != nil {
+				return err
+			}
+		}
+
+		if d.Get("verify_checksum").(bool) {
+			if err := imagesImageV2VerifyHashes(img, hashes); err != nil {
+				return err
+			}
+		}
 	}
 
 	d.Partial(false)
@@ -295,6 +412,8 @@ func resourceImagesImageV2Read(d *schema.ResourceData, meta interface{}) error {
 	d.Set("file", img.File)
 	d.Set("schema", img.Schema)
 	d.Set("checksum", img.Checksum)
+	d.Set("os_hash_algo", imagesImageV2Property(img, "os_hash_algo"))
+	d.Set("os_hash_value", imagesImageV2Property(img, "os_hash_value"))
 	d.Set("size_bytes", img.SizeBytes)
 	d.Set("metadata", img.Metadata)
 	d.Set("created_at", img.CreatedAt)
@@ -309,6 +428,7 @@ func resourceImagesImageV2Read(d *schema.ResourceData, meta interface{}) error {
 	d.Set("size_bytes", img.SizeBytes)
 	d.Set("tags", img.Tags)
 	d.Set("visibility", img.Visibility)
+	d.Set("stores", imagesImageV2Stores(img))
 	d.Set("region", GetRegion(d, config))
 
 	properties := resourceImagesImageV2ExpandProperties(img.Properties)
@@ -369,10 +489,11 @@ func resourceImagesImageV2Update(d *schema.ResourceData, meta interface{}) error
 				changed = false
 			}
 
-			// direct_url is provided by some storage drivers.
-			// This is a read-only property that cannot be modified.
-			// Ignore it here and let CustomizeDiff handle it.
-			if newKey == "direct_url" {
+			// direct_url is provided by some storage drivers and stores by
+			// the Image service when multiple stores are enabled.
+			// These are read-only properties that cannot be modified.
+			// Ignore them here and let CustomizeDiff handle them.
+			if newKey == "direct_url" || newKey == "stores" {
 				found = true
 				changed = false
 			}
@@ -420,6 +541,37 @@ func resourceImagesImageV2Update(d *schema.ResourceData, meta interface{}) error
 		return fmt.Errorf("Error updating image: %s", err)
 	}
 
+	if d.HasChange("stores") {
+		o, n := d.GetChange("stores")
+		newStores := n.(*schema.Set).Difference(o.(*schema.Set)).List()
+
+		// Removed stores require a new image, see CustomizeDiff.
+		if len(newStores) > 0 {
+			importOpts := imageimport.CreateOpts{
+				Name:   imageimport.CopyImageMethod,
+				Stores: resourceImagesImageV2BuildTags(newStores),
+			}
+
+			log.Printf("[DEBUG] Import Options: %#v", importOpts)
+			if err := imageimport.Create(imageClient, d.Id(), importOpts).ExtractErr(); err != nil {
+				return fmt.Errorf("Error copying image %s to stores: %s", d.Id(), err)
+			}
+
+			stateConf := &resource.StateChangeConf{
+				Pending:    []string{string(imagesImageV2StatusImporting)},
+				Target:     []string{string(images.ImageStatusActive)},
+				Refresh:    imagesImageV2ImportRefreshFunc(imageClient, d.Id()),
+				Timeout:    d.Timeout(schema.TimeoutUpdate),
+				Delay:      10 * time.Second,
+				MinTimeout: 3 * time.Second,
+			}
+
+			if _, err = stateConf.WaitForState(); err != nil {
+				return fmt.Errorf("Error waiting for image %s to be copied to stores: %s", d.Id(), err)
+			}
+		}
+	}
+
 	return resourceImagesImageV2Read(d, meta)
 }
 
@@ -524,40 +676,6 @@ func resourceImagesImageV2VisibilityFromString(v string) images.ImageVisibility
 	return ""
 }
 
-func fileMD5Checksum(f *os.File) (string, error) {
-	hash := md5.New()
-	if _, err := io.Copy(hash, f); err != nil {
-		return "", err
-	}
-	return hex.EncodeToString(hash.Sum(nil)), nil
-}
-
-func resourceImagesImageV2FileProps(filename string) (int64, string, error) {
-	var filesize int64
-	var filechecksum string
-
-	file, err := os.Open(filename)
-	if err != nil {
-		return -1, "", fmt.Errorf("Error opening file for Image: %s", err)
-
-	}
-	defer file.Close()
-
-	fstat, err := file.Stat()
-	if err != nil {
-		return -1, "", fmt.Errorf("Error reading image file %q: %s", file.Name(), err)
-	}
-
-	filesize = fstat.Size()
-	filechecksum, err = fileMD5Checksum(file)
-
-	if err != nil {
-		return -1, "", fmt.Errorf("Error computing image file %q checksum: %s", file.Name(), err)
-	}
-
-	return filesize, filechecksum, nil
-}
-
 func resourceImagesImageV2File(d *schema.ResourceData) (string, error) {
 	if filename := d.Get("local_file_path").(string); filename != "" {
 		return filename, nil
@@ -582,12 +700,36 @@ func resourceImagesImageV2File(d *schema.ResourceData) (string, error) {
 			}
 			defer resp.Body.Close()
 
-			if _, err = io.Copy(file, resp.Body); err != nil {
+			hashes := newImagesImageV2Hashes()
+			if _, err = io.Copy(file, hashes.Reader(resp.Body)); err != nil {
 				return "", fmt.Errorf("Error downloading image %q to file %q: %s", furl, filename, err)
 			}
+
+			// A file which doesn't match is removed, so that it isn't
+			// used from the cache next time.
+			if expected := d.Get("image_source_sha256").(string); expected != "" {
+				sum, _ := hashes.Sum("sha256")
+				if err := imagesImageV2VerifySHA256(expected, sum); err != nil {
+					file.Close()
+					os.Remove(filename)
+					return "", fmt.Errorf("Error downloading image %q: %s", furl, err)
+				}
+			}
 			return filename, nil
 		} else {
 			log.Printf("[DEBUG] File exists %s", filename)
+
+			// A cached file which doesn't match is removed as well.
+			if expected := d.Get("image_source_sha256").(string); expected != "" {
+				sum, err := imagesImageV2FileSHA256(filename)
+				if err != nil {
+					return "", fmt.Errorf("Error computing image file %q checksum: %s", filename, err)
+				}
+				if err := imagesImageV2VerifySHA256(expected, sum); err != nil {
+					os.Remove(filename)
+					return "", fmt.Errorf("Error in cached image %q of %q: %s", filename, furl, err)
+				}
+			}
 			return filename, nil
 		}
 	} else {
@@ -628,6 +770,12 @@ func resourceImagesImageV2ExpandProperties(v map[string]interface{}) map[string]
 }
 
 func resourceImagesImageV2UpdateComputedAttributes(diff *schema.ResourceDiff, meta interface{}) error {
+	// The Image service downloads the image itself with the web-download
+	// import method, so Terraform has no data to compare the hash with.
+	if diff.Get("import_method").(string) == string(imageimport.WebDownloadMethod) && diff.Get("image_source_sha256").(string) != "" {
+		return fmt.Errorf("image_source_sha256 can't be used with the %s import_method", imageimport.WebDownloadMethod)
+	}
+
 	if diff.HasChange("properties") {
 		// Only check if the image has been created.
 		if diff.Id() != "" {
@@ -648,8 +796,9 @@ func resourceImagesImageV2UpdateComputedAttributes(diff *schema.ResourceDiff, me
 					}
 				}
 
-				// direct_url is provided by some storage drivers.
-				if oldKey == "direct_url" {
+				// direct_url is provided by some storage drivers and stores
+				// by the Image service when multiple stores are enabled.
+				if oldKey == "direct_url" || oldKey == "stores" {
 					if v, ok := oldValue.(string); ok {
 						newProperties[oldKey] = v
 					}
@@ -665,5 +814,16 @@ func resourceImagesImageV2UpdateComputedAttributes(diff *schema.ResourceDiff, me
 		}
 	}
 
+	// Image data can be copied to new stores, but removing it from a store
+	// requires a new image.
+	if diff.Id() != "" && diff.HasChange("stores") {
+		o, n := diff.GetChange("stores")
+		if o.(*schema.Set).Difference(n.(*schema.Set)).Len() > 0 {
+			if err := diff.ForceNew("stores"); err != nil {
+				return err
+			}
+		}
+	}
+
 	return nil
 }
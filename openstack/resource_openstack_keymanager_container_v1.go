@@ -0,0 +1,252 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/samuelbernardolip/gophercloud/openstack/keymanager/v1/containers"
+)
+
+func resourceKeyManagerContainerV1() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceKeyManagerContainerV1Create,
+		Read:   resourceKeyManagerContainerV1Read,
+		Update: resourceKeyManagerContainerV1Update,
+		Delete: resourceKeyManagerContainerV1Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		CustomizeDiff: keyManagerContainerV1ForceNewSecretRefs,
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"type": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"generic", "rsa", "certificate",
+				}, false),
+			},
+
+			"secret_refs": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": &schema.Schema{
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"secret_ref": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+
+			"container_ref": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"creator_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"status": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"created_at": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"updated_at": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"consumers": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"url": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceKeyManagerContainerV1Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	kmClient, err := config.keyManagerV1Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack key manager client: %s", err)
+	}
+
+	createOpts := containers.CreateOpts{
+		Name:       d.Get("name").(string),
+		Type:       keyManagerContainerV1Type(d.Get("type").(string)),
+		SecretRefs: expandKeyManagerContainerV1SecretRefs(d.Get("secret_refs").(*schema.Set)),
+	}
+
+	log.Printf("[DEBUG] openstack_keymanager_container_v1 create options: %#v", createOpts)
+
+	container, err := containers.Create(kmClient, createOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error creating openstack_keymanager_container_v1: %s", err)
+	}
+
+	uuid := keyManagerV1GetUUIDfromRef(container.ContainerRef)
+	d.SetId(uuid)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"NOT_CREATED", "PENDING"},
+		Target:     []string{"ACTIVE"},
+		Refresh:    keyManagerContainerV1WaitForContainerCreation(kmClient, uuid),
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		Delay:      0,
+		MinTimeout: 2 * time.Second,
+	}
+
+	_, err = stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf("Error waiting for openstack_keymanager_container_v1 %s to become active: %s", uuid, err)
+	}
+
+	log.Printf("[DEBUG] Created openstack_keymanager_container_v1 %s", uuid)
+
+	return resourceKeyManagerContainerV1Read(d, meta)
+}
+
+func resourceKeyManagerContainerV1Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	kmClient, err := config.keyManagerV1Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack key manager client: %s", err)
+	}
+
+	container, err := containers.Get(kmClient, d.Id()).Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "Error retrieving openstack_keymanager_container_v1")
+	}
+
+	log.Printf("[DEBUG] Retrieved openstack_keymanager_container_v1 %s: %#v", d.Id(), container)
+
+	d.Set("name", container.Name)
+	d.Set("type", container.Type)
+	d.Set("container_ref", container.ContainerRef)
+	d.Set("creator_id", container.CreatorID)
+	d.Set("status", container.Status)
+	d.Set("created_at", container.Created.Format(time.RFC3339))
+	d.Set("updated_at", container.Updated.Format(time.RFC3339))
+
+	if err := d.Set("secret_refs", flattenKeyManagerContainerV1SecretRefs(container.SecretRefs)); err != nil {
+		return fmt.Errorf("Unable to set openstack_keymanager_container_v1 secret_refs: %s", err)
+	}
+
+	if err := d.Set("consumers", flattenKeyManagerContainerV1Consumers(container.Consumers)); err != nil {
+		return fmt.Errorf("Unable to set openstack_keymanager_container_v1 consumers: %s", err)
+	}
+
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceKeyManagerContainerV1Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	kmClient, err := config.keyManagerV1Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack key manager client: %s", err)
+	}
+
+	if d.HasChange("secret_refs") {
+		o, n := d.GetChange("secret_refs")
+		oldSecretRefs := o.(*schema.Set)
+		newSecretRefs := n.(*schema.Set)
+
+		for _, secretRef := range expandKeyManagerContainerV1SecretRefs(oldSecretRefs.Difference(newSecretRefs)) {
+			log.Printf("[DEBUG] Removing %#v from openstack_keymanager_container_v1 %s", secretRef, d.Id())
+			err := containers.DeleteSecretRef(kmClient, d.Id(), secretRef).ExtractErr()
+			if err != nil {
+				return fmt.Errorf("Error removing secret %s from openstack_keymanager_container_v1 %s: %s", secretRef.SecretRef, d.Id(), err)
+			}
+		}
+
+		for _, secretRef := range expandKeyManagerContainerV1SecretRefs(newSecretRefs.Difference(oldSecretRefs)) {
+			log.Printf("[DEBUG] Adding %#v to openstack_keymanager_container_v1 %s", secretRef, d.Id())
+			_, err := containers.CreateSecretRef(kmClient, d.Id(), secretRef).Extract()
+			if err != nil {
+				return fmt.Errorf("Error adding secret %s to openstack_keymanager_container_v1 %s: %s", secretRef.SecretRef, d.Id(), err)
+			}
+		}
+	}
+
+	return resourceKeyManagerContainerV1Read(d, meta)
+}
+
+func resourceKeyManagerContainerV1Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	kmClient, err := config.keyManagerV1Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack key manager client: %s", err)
+	}
+
+	err = containers.Delete(kmClient, d.Id()).ExtractErr()
+	if err != nil {
+		return CheckDeleted(d, err, "Error deleting openstack_keymanager_container_v1")
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"ACTIVE"},
+		Target:     []string{"DELETED"},
+		Refresh:    keyManagerContainerV1WaitForContainerDeletion(kmClient, d.Id()),
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		Delay:      0,
+		MinTimeout: 2 * time.Second,
+	}
+
+	_, err = stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf("Error waiting for openstack_keymanager_container_v1 %s to delete: %s", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,180 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+
+	"github.com/samuelbernardolip/gophercloud/openstack/keymanager/v1/containers"
+)
+
+func TestAccKeyManagerContainerV1_basic(t *testing.T) {
+	var container containers.Container
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckKeyManager(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKeyManagerContainerV1Destroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccKeyManagerContainerV1_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeyManagerContainerV1Exists("openstack_keymanager_container_v1.container_1", &container),
+					resource.TestCheckResourceAttr("openstack_keymanager_container_v1.container_1", "name", "container_1"),
+					resource.TestCheckResourceAttr("openstack_keymanager_container_v1.container_1", "type", "generic"),
+					resource.TestCheckResourceAttr("openstack_keymanager_container_v1.container_1", "secret_refs.#", "1"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccKeyManagerContainerV1_update,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeyManagerContainerV1Exists("openstack_keymanager_container_v1.container_1", &container),
+					resource.TestCheckResourceAttr("openstack_keymanager_container_v1.container_1", "secret_refs.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKeyManagerContainerV1_certificate(t *testing.T) {
+	var container containers.Container
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckKeyManager(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKeyManagerContainerV1Destroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccKeyManagerContainerV1_certificate,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeyManagerContainerV1Exists("openstack_keymanager_container_v1.container_1", &container),
+					resource.TestCheckResourceAttr("openstack_keymanager_container_v1.container_1", "type", "certificate"),
+					resource.TestCheckResourceAttr("openstack_keymanager_container_v1.container_1", "secret_refs.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckKeyManagerContainerV1Destroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	kmClient, err := config.keyManagerV1Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack key manager client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "openstack_keymanager_container_v1" {
+			continue
+		}
+
+		_, err := containers.Get(kmClient, rs.Primary.ID).Extract()
+		if err == nil {
+			return fmt.Errorf("Container still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckKeyManagerContainerV1Exists(n string, container *containers.Container) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		kmClient, err := config.keyManagerV1Client(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack key manager client: %s", err)
+		}
+
+		found, err := containers.Get(kmClient, rs.Primary.ID).Extract()
+		if err != nil {
+			return err
+		}
+
+		if keyManagerV1GetUUIDfromRef(found.ContainerRef) != rs.Primary.ID {
+			return fmt.Errorf("Container not found")
+		}
+
+		*container = *found
+
+		return nil
+	}
+}
+
+const testAccKeyManagerContainerV1_secrets = `
+resource "openstack_keymanager_secret_v1" "secret_1" {
+  name = "secret_1"
+  payload = "foo"
+  payload_content_type = "text/plain"
+  secret_type = "passphrase"
+}
+
+resource "openstack_keymanager_secret_v1" "secret_2" {
+  name = "secret_2"
+  payload = "bar"
+  payload_content_type = "text/plain"
+  secret_type = "passphrase"
+}
+`
+
+var testAccKeyManagerContainerV1_basic = fmt.Sprintf(`
+%s
+
+resource "openstack_keymanager_container_v1" "container_1" {
+  name = "container_1"
+  type = "generic"
+
+  secret_refs {
+    name = "foo"
+    secret_ref = "${openstack_keymanager_secret_v1.secret_1.secret_ref}"
+  }
+}
+`, testAccKeyManagerContainerV1_secrets)
+
+var testAccKeyManagerContainerV1_update = fmt.Sprintf(`
+%s
+
+resource "openstack_keymanager_container_v1" "container_1" {
+  name = "container_1"
+  type = "generic"
+
+  secret_refs {
+    name = "foo"
+    secret_ref = "${openstack_keymanager_secret_v1.secret_1.secret_ref}"
+  }
+
+  secret_refs {
+    name = "bar"
+    secret_ref = "${openstack_keymanager_secret_v1.secret_2.secret_ref}"
+  }
+}
+`, testAccKeyManagerContainerV1_secrets)
+
+var testAccKeyManagerContainerV1_certificate = fmt.Sprintf(`
+%s
+
+resource "openstack_keymanager_container_v1" "container_1" {
+  name = "container_1"
+  type = "certificate"
+
+  secret_refs {
+    name = "certificate"
+    secret_ref = "${openstack_keymanager_secret_v1.secret_1.secret_ref}"
+  }
+
+  secret_refs {
+    name = "private_key"
+    secret_ref = "${openstack_keymanager_secret_v1.secret_2.secret_ref}"
+  }
+}
+`, testAccKeyManagerContainerV1_secrets)
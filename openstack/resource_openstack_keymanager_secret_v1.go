@@ -0,0 +1,317 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/samuelbernardolip/gophercloud/openstack/keymanager/v1/secrets"
+)
+
+func resourceKeyManagerSecretV1() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceKeyManagerSecretV1Create,
+		Read:   resourceKeyManagerSecretV1Read,
+		Update: resourceKeyManagerSecretV1Update,
+		Delete: resourceKeyManagerSecretV1Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"bit_length": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"algorithm": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"mode": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"secret_type": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"symmetric", "public", "private", "passphrase", "certificate", "opaque",
+				}, false),
+			},
+
+			"payload": &schema.Schema{
+				Type:             schema.TypeString,
+				Optional:         true,
+				Sensitive:        true,
+				ForceNew:         true,
+				Computed:         true,
+				DiffSuppressFunc: suppressKeyManagerSecretV1PayloadDiffs,
+			},
+
+			"payload_content_type": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"text/plain", "text/plain;charset=utf-8", "text/plain; charset=utf-8",
+					"application/octet-stream", "application/pkcs8", "application/pkix-cert",
+				}, true),
+				DiffSuppressFunc: suppressKeyManagerSecretV1ContentTypeDiffs,
+			},
+
+			"payload_content_encoding": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"base64", "binary",
+				}, false),
+			},
+
+			"expiration": &schema.Schema{
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				ValidateFunc:     validation.ValidateRFC3339TimeString,
+				DiffSuppressFunc: suppressEquivilentTimeDiffs,
+			},
+
+			"metadata": &schema.Schema{
+				Type:     schema.TypeMap,
+				Optional: true,
+			},
+
+			"secret_ref": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"creator_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"status": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"content_types": &schema.Schema{
+				Type:     schema.TypeMap,
+				Computed: true,
+			},
+
+			"created_at": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"updated_at": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceKeyManagerSecretV1Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	kmClient, err := config.keyManagerV1Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack key manager client: %s", err)
+	}
+
+	createOpts := secrets.CreateOpts{
+		Name:                   d.Get("name").(string),
+		Algorithm:              d.Get("algorithm").(string),
+		BitLength:              d.Get("bit_length").(int),
+		Mode:                   d.Get("mode").(string),
+		Payload:                d.Get("payload").(string),
+		PayloadContentType:     d.Get("payload_content_type").(string),
+		PayloadContentEncoding: d.Get("payload_content_encoding").(string),
+		SecretType:             keyManagerSecretV1SecretType(d.Get("secret_type").(string)),
+	}
+
+	if v, ok := d.GetOk("expiration"); ok {
+		expiration, err := time.Parse(time.RFC3339, v.(string))
+		if err != nil {
+			return fmt.Errorf("Error parsing expiration for openstack_keymanager_secret_v1: %s", err)
+		}
+		expiration = expiration.UTC()
+		createOpts.Expiration = &expiration
+	}
+
+	// Don't log the payload.
+	logOpts := createOpts
+	logOpts.Payload = "***"
+	log.Printf("[DEBUG] openstack_keymanager_secret_v1 create options: %#v", logOpts)
+
+	secret, err := secrets.Create(kmClient, createOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error creating openstack_keymanager_secret_v1: %s", err)
+	}
+
+	uuid := keyManagerV1GetUUIDfromRef(secret.SecretRef)
+	d.SetId(uuid)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"NOT_CREATED", "PENDING"},
+		Target:     []string{"ACTIVE"},
+		Refresh:    keyManagerSecretV1WaitForSecretCreation(kmClient, uuid),
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		Delay:      0,
+		MinTimeout: 2 * time.Second,
+	}
+
+	_, err = stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf("Error waiting for openstack_keymanager_secret_v1 %s to become active: %s", uuid, err)
+	}
+
+	metadata := expandKeyManagerSecretV1Metadata(d)
+	if len(metadata) > 0 {
+		_, err = secrets.CreateMetadata(kmClient, uuid, metadata).Extract()
+		if err != nil {
+			return fmt.Errorf("Error setting metadata on openstack_keymanager_secret_v1 %s: %s", uuid, err)
+		}
+	}
+
+	log.Printf("[DEBUG] Created openstack_keymanager_secret_v1 %s", uuid)
+
+	return resourceKeyManagerSecretV1Read(d, meta)
+}
+
+func resourceKeyManagerSecretV1Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	kmClient, err := config.keyManagerV1Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack key manager client: %s", err)
+	}
+
+	secret, err := secrets.Get(kmClient, d.Id()).Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "Error retrieving openstack_keymanager_secret_v1")
+	}
+
+	log.Printf("[DEBUG] Retrieved openstack_keymanager_secret_v1 %s: %#v", d.Id(), secret)
+
+	d.Set("name", secret.Name)
+	d.Set("bit_length", secret.BitLength)
+	d.Set("algorithm", secret.Algorithm)
+	d.Set("mode", secret.Mode)
+	d.Set("secret_type", secret.SecretType)
+	d.Set("secret_ref", secret.SecretRef)
+	d.Set("creator_id", secret.CreatorID)
+	d.Set("status", secret.Status)
+	d.Set("content_types", secret.ContentTypes)
+	d.Set("created_at", secret.Created.Format(time.RFC3339))
+	d.Set("updated_at", secret.Updated.Format(time.RFC3339))
+
+	if !secret.Expiration.IsZero() {
+		d.Set("expiration", secret.Expiration.Format(time.RFC3339))
+	}
+
+	// A secret without a payload has no content types.
+	if contentType, ok := secret.ContentTypes["default"]; ok {
+		payload, err := keyManagerSecretV1GetPayload(kmClient, d.Id(), contentType)
+		if err != nil {
+			return fmt.Errorf("Error retrieving payload of openstack_keymanager_secret_v1 %s: %s", d.Id(), err)
+		}
+		d.Set("payload", payload)
+		d.Set("payload_content_type", contentType)
+	}
+
+	metadata, err := secrets.GetMetadata(kmClient, d.Id()).Extract()
+	if err != nil {
+		return fmt.Errorf("Error retrieving metadata of openstack_keymanager_secret_v1 %s: %s", d.Id(), err)
+	}
+	d.Set("metadata", metadata)
+
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceKeyManagerSecretV1Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	kmClient, err := config.keyManagerV1Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack key manager client: %s", err)
+	}
+
+	// Secrets are immutable, only their metadata can be changed.
+	// Setting the metadata replaces all of the existing metadata.
+	if d.HasChange("metadata") {
+		metadata := expandKeyManagerSecretV1Metadata(d)
+		log.Printf("[DEBUG] openstack_keymanager_secret_v1 %s metadata: %#v", d.Id(), metadata)
+
+		_, err = secrets.CreateMetadata(kmClient, d.Id(), metadata).Extract()
+		if err != nil {
+			return fmt.Errorf("Error updating metadata of openstack_keymanager_secret_v1 %s: %s", d.Id(), err)
+		}
+	}
+
+	return resourceKeyManagerSecretV1Read(d, meta)
+}
+
+func resourceKeyManagerSecretV1Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	kmClient, err := config.keyManagerV1Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack key manager client: %s", err)
+	}
+
+	err = secrets.Delete(kmClient, d.Id()).ExtractErr()
+	if err != nil {
+		return CheckDeleted(d, err, "Error deleting openstack_keymanager_secret_v1")
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"ACTIVE"},
+		Target:     []string{"DELETED"},
+		Refresh:    keyManagerSecretV1WaitForSecretDeletion(kmClient, d.Id()),
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		Delay:      0,
+		MinTimeout: 2 * time.Second,
+	}
+
+	_, err = stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf("Error waiting for openstack_keymanager_secret_v1 %s to delete: %s", d.Id(), err)
+	}
+
+	return nil
+}
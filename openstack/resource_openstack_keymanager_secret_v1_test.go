@@ -0,0 +1,190 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+
+	"github.com/samuelbernardolip/gophercloud/openstack/keymanager/v1/secrets"
+)
+
+func TestAccKeyManagerSecretV1_basic(t *testing.T) {
+	var secret secrets.Secret
+	var secretName = fmt.Sprintf("ACPTTEST%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckKeyManager(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKeyManagerSecretV1Destroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccKeyManagerSecretV1_basic(secretName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeyManagerSecretV1Exists("openstack_keymanager_secret_v1.secret_1", &secret),
+					resource.TestCheckResourceAttr("openstack_keymanager_secret_v1.secret_1", "name", secretName),
+					resource.TestCheckResourceAttr("openstack_keymanager_secret_v1.secret_1", "secret_type", "passphrase"),
+					resource.TestCheckResourceAttr("openstack_keymanager_secret_v1.secret_1", "payload", "foobar"),
+					resource.TestCheckResourceAttr("openstack_keymanager_secret_v1.secret_1", "status", "ACTIVE"),
+					resource.TestCheckResourceAttrSet("openstack_keymanager_secret_v1.secret_1", "secret_ref"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKeyManagerSecretV1_binaryPayload(t *testing.T) {
+	var secret secrets.Secret
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckKeyManager(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKeyManagerSecretV1Destroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccKeyManagerSecretV1_binaryPayload,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeyManagerSecretV1Exists("openstack_keymanager_secret_v1.secret_1", &secret),
+					resource.TestCheckResourceAttr("openstack_keymanager_secret_v1.secret_1", "payload", "Zm9vYmFy"),
+					resource.TestCheckResourceAttr(
+						"openstack_keymanager_secret_v1.secret_1", "payload_content_type", "application/octet-stream"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKeyManagerSecretV1_metadata(t *testing.T) {
+	var secret secrets.Secret
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckKeyManager(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKeyManagerSecretV1Destroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccKeyManagerSecretV1_metadata,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeyManagerSecretV1Exists("openstack_keymanager_secret_v1.secret_1", &secret),
+					resource.TestCheckResourceAttr("openstack_keymanager_secret_v1.secret_1", "metadata.%", "1"),
+					resource.TestCheckResourceAttr("openstack_keymanager_secret_v1.secret_1", "metadata.foo", "bar"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccKeyManagerSecretV1_metadataUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeyManagerSecretV1Exists("openstack_keymanager_secret_v1.secret_1", &secret),
+					resource.TestCheckResourceAttr("openstack_keymanager_secret_v1.secret_1", "metadata.%", "2"),
+					resource.TestCheckResourceAttr("openstack_keymanager_secret_v1.secret_1", "metadata.foo", "baz"),
+					resource.TestCheckResourceAttr("openstack_keymanager_secret_v1.secret_1", "metadata.hello", "world"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckKeyManagerSecretV1Destroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	kmClient, err := config.keyManagerV1Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack key manager client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "openstack_keymanager_secret_v1" {
+			continue
+		}
+
+		_, err := secrets.Get(kmClient, rs.Primary.ID).Extract()
+		if err == nil {
+			return fmt.Errorf("Secret still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckKeyManagerSecretV1Exists(n string, secret *secrets.Secret) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		kmClient, err := config.keyManagerV1Client(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack key manager client: %s", err)
+		}
+
+		found, err := secrets.Get(kmClient, rs.Primary.ID).Extract()
+		if err != nil {
+			return err
+		}
+
+		if keyManagerV1GetUUIDfromRef(found.SecretRef) != rs.Primary.ID {
+			return fmt.Errorf("Secret not found")
+		}
+
+		*secret = *found
+
+		return nil
+	}
+}
+
+func testAccKeyManagerSecretV1_basic(secretName string) string {
+	return fmt.Sprintf(`
+		resource "openstack_keymanager_secret_v1" "secret_1" {
+			name = "%s"
+			algorithm = "aes"
+			bit_length = 192
+			mode = "cbc"
+			payload = "foobar"
+			payload_content_type = "text/plain"
+			secret_type = "passphrase"
+		}
+	`, secretName)
+}
+
+const testAccKeyManagerSecretV1_binaryPayload = `
+resource "openstack_keymanager_secret_v1" "secret_1" {
+  name = "secret_1"
+  payload = "Zm9vYmFy"
+  payload_content_type = "application/octet-stream"
+  payload_content_encoding = "base64"
+  secret_type = "opaque"
+}
+`
+
+const testAccKeyManagerSecretV1_metadata = `
+resource "openstack_keymanager_secret_v1" "secret_1" {
+  name = "secret_1"
+  payload = "foobar"
+  payload_content_type = "text/plain"
+  secret_type = "passphrase"
+
+  metadata {
+    foo = "bar"
+  }
+}
+`
+
+const testAccKeyManagerSecretV1_metadataUpdate = `
+resource "openstack_keymanager_secret_v1" "secret_1" {
+  name = "secret_1"
+  payload = "foobar"
+  payload_content_type = "text/plain"
+  secret_type = "passphrase"
+
+  metadata {
+    foo = "baz"
+    hello = "world"
+  }
+}
+`
@@ -0,0 +1,334 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+
+	"github.com/samuelbernardolip/gophercloud/openstack/networking/v2/extensions/lbaas_v2/l7policies"
+)
+
+func resourceL7PolicyV2() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceL7PolicyV2Create,
+		Read:   resourceL7PolicyV2Read,
+		Update: resourceL7PolicyV2Update,
+		Delete: resourceL7PolicyV2Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"tenant_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"action": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"REDIRECT_TO_POOL", "REDIRECT_TO_URL", "REJECT",
+				}, false),
+			},
+
+			"listener_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"position": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+
+			"redirect_pool_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"redirect_url": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"admin_state_up": &schema.Schema{
+				Type:     schema.TypeBool,
+				Default:  true,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func resourceL7PolicyV2Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	lbClient, err := chooseLBV2Client(d, config)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	action := l7policies.Action(d.Get("action").(string))
+	redirectPoolID := d.Get("redirect_pool_id").(string)
+	redirectURL := d.Get("redirect_url").(string)
+	if err := checkL7PolicyV2Action(action, redirectPoolID, redirectURL); err != nil {
+		return err
+	}
+
+	adminStateUp := d.Get("admin_state_up").(bool)
+	createOpts := l7policies.CreateOpts{
+		TenantID:       d.Get("tenant_id").(string),
+		Name:           d.Get("name").(string),
+		Description:    d.Get("description").(string),
+		Action:         action,
+		ListenerID:     d.Get("listener_id").(string),
+		Position:       int32(d.Get("position").(int)),
+		RedirectPoolID: redirectPoolID,
+		RedirectURL:    redirectURL,
+		AdminStateUp:   &adminStateUp,
+	}
+
+	log.Printf("[DEBUG] Create Options: %#v", createOpts)
+
+	// Wait for LoadBalancer to become active before continuing
+	lbID, err := getLBV2LoadBalancerIDByListener(lbClient, createOpts.ListenerID)
+	if err != nil {
+		return err
+	}
+
+	timeout := d.Timeout(schema.TimeoutCreate)
+	err = waitForLBV2LoadBalancer(lbClient, lbID, "ACTIVE", nil, timeout)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Attempting to create l7policy")
+	var l7policy *l7policies.L7Policy
+	err = resource.Retry(timeout, func() *resource.RetryError {
+		l7policy, err = l7policies.Create(lbClient, createOpts).Extract()
+		if err != nil {
+			return checkForRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("Error creating l7policy: %s", err)
+	}
+
+	// Wait for LoadBalancer to become active again before continuing
+	err = waitForLBV2LoadBalancer(lbClient, lbID, "ACTIVE", nil, timeout)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(l7policy.ID)
+
+	return resourceL7PolicyV2Read(d, meta)
+}
+
+func resourceL7PolicyV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	lbClient, err := chooseLBV2Client(d, config)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	l7policy, err := l7policies.Get(lbClient, d.Id()).Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "l7policy")
+	}
+
+	log.Printf("[DEBUG] Retrieved l7policy %s: %#v", d.Id(), l7policy)
+
+	d.Set("tenant_id", l7policy.TenantID)
+	d.Set("name", l7policy.Name)
+	d.Set("description", l7policy.Description)
+	d.Set("action", l7policy.Action)
+	d.Set("listener_id", l7policy.ListenerID)
+	d.Set("position", int(l7policy.Position))
+	d.Set("redirect_pool_id", l7policy.RedirectPoolID)
+	d.Set("redirect_url", l7policy.RedirectURL)
+	d.Set("admin_state_up", l7policy.AdminStateUp)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceL7PolicyV2Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	lbClient, err := chooseLBV2Client(d, config)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	action := l7policies.Action(d.Get("action").(string))
+	redirectPoolID := d.Get("redirect_pool_id").(string)
+	redirectURL := d.Get("redirect_url").(string)
+	if err := checkL7PolicyV2Action(action, redirectPoolID, redirectURL); err != nil {
+		return err
+	}
+
+	var updateOpts l7policies.UpdateOpts
+	if d.HasChange("name") {
+		name := d.Get("name").(string)
+		updateOpts.Name = &name
+	}
+	if d.HasChange("description") {
+		description := d.Get("description").(string)
+		updateOpts.Description = &description
+	}
+	if d.HasChange("action") {
+		updateOpts.Action = action
+	}
+	if d.HasChange("position") {
+		updateOpts.Position = int32(d.Get("position").(int))
+	}
+	if d.HasChange("redirect_pool_id") {
+		updateOpts.RedirectPoolID = &redirectPoolID
+	}
+	if d.HasChange("redirect_url") {
+		updateOpts.RedirectURL = &redirectURL
+	}
+	if d.HasChange("admin_state_up") {
+		asu := d.Get("admin_state_up").(bool)
+		updateOpts.AdminStateUp = &asu
+	}
+
+	// Wait for LoadBalancer to become active before continuing
+	lbID, err := getLBV2LoadBalancerIDByListener(lbClient, d.Get("listener_id").(string))
+	if err != nil {
+		return err
+	}
+
+	timeout := d.Timeout(schema.TimeoutUpdate)
+	err = waitForLBV2LoadBalancer(lbClient, lbID, "ACTIVE", nil, timeout)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Updating l7policy %s with options: %#v", d.Id(), updateOpts)
+	err = resource.Retry(timeout, func() *resource.RetryError {
+		_, err = l7policies.Update(lbClient, d.Id(), updateOpts).Extract()
+		if err != nil {
+			return checkForRetryableError(err)
+		}
+		return nil
+	})
+
+	if err != nil {
+		return fmt.Errorf("Error updating l7policy %s: %s", d.Id(), err)
+	}
+
+	// Wait for LoadBalancer to become active again before continuing
+	err = waitForLBV2LoadBalancer(lbClient, lbID, "ACTIVE", nil, timeout)
+	if err != nil {
+		return err
+	}
+
+	return resourceL7PolicyV2Read(d, meta)
+}
+
+func resourceL7PolicyV2Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	lbClient, err := chooseLBV2Client(d, config)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	// Wait for LoadBalancer to become active before continuing
+	lbID, err := getLBV2LoadBalancerIDByListener(lbClient, d.Get("listener_id").(string))
+	if err != nil {
+		return CheckDeleted(d, err, "Error retrieving listener")
+	}
+
+	timeout := d.Timeout(schema.TimeoutDelete)
+	err = waitForLBV2LoadBalancer(lbClient, lbID, "ACTIVE", nil, timeout)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting l7policy %s", d.Id())
+	err = resource.Retry(timeout, func() *resource.RetryError {
+		err = l7policies.Delete(lbClient, d.Id()).ExtractErr()
+		if err != nil {
+			return checkForRetryableError(err)
+		}
+		return nil
+	})
+
+	if err != nil {
+		return CheckDeleted(d, err, "Error deleting l7policy")
+	}
+
+	// Wait for LoadBalancer to become active again before continuing
+	err = waitForLBV2LoadBalancer(lbClient, lbID, "ACTIVE", nil, timeout)
+	if err != nil {
+		return err
+	}
+
+	// Wait for L7 Policy to delete
+	err = waitForLBV2L7Policy(lbClient, d.Id(), "DELETED", nil, timeout)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// checkL7PolicyV2Action ensures that only the redirect argument matching the
+// action is set.
+func checkL7PolicyV2Action(action l7policies.Action, redirectPoolID, redirectURL string) error {
+	switch action {
+	case l7policies.ActionRedirectToPool:
+		if redirectPoolID == "" {
+			return fmt.Errorf("redirect_pool_id must be set when action is %s", action)
+		}
+		if redirectURL != "" {
+			return fmt.Errorf("redirect_url can't be set when action is %s", action)
+		}
+	case l7policies.ActionRedirectToURL:
+		if redirectURL == "" {
+			return fmt.Errorf("redirect_url must be set when action is %s", action)
+		}
+		if redirectPoolID != "" {
+			return fmt.Errorf("redirect_pool_id can't be set when action is %s", action)
+		}
+	case l7policies.ActionReject:
+		if redirectPoolID != "" || redirectURL != "" {
+			return fmt.Errorf("redirect_pool_id and redirect_url can't be set when action is %s", action)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,357 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+
+	"github.com/samuelbernardolip/gophercloud/openstack/networking/v2/extensions/lbaas_v2/l7policies"
+)
+
+func resourceL7RuleV2() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceL7RuleV2Create,
+		Read:   resourceL7RuleV2Read,
+		Update: resourceL7RuleV2Update,
+		Delete: resourceL7RuleV2Delete,
+		Importer: &schema.ResourceImporter{
+			State: resourceL7RuleV2Import,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"tenant_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"type": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"COOKIE", "FILE_TYPE", "HEADER", "HOST_NAME", "PATH",
+				}, false),
+			},
+
+			"compare_type": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"CONTAINS", "STARTS_WITH", "ENDS_WITH", "EQUAL_TO", "REGEX",
+				}, false),
+			},
+
+			"l7policy_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"listener_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"value": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"key": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"invert": &schema.Schema{
+				Type:     schema.TypeBool,
+				Default:  false,
+				Optional: true,
+			},
+
+			"admin_state_up": &schema.Schema{
+				Type:     schema.TypeBool,
+				Default:  true,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func resourceL7RuleV2Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	lbClient, err := chooseLBV2Client(d, config)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	ruleType := l7policies.RuleType(d.Get("type").(string))
+	key := d.Get("key").(string)
+	if err := checkL7RuleV2Type(ruleType, key); err != nil {
+		return err
+	}
+
+	adminStateUp := d.Get("admin_state_up").(bool)
+	createOpts := l7policies.CreateRuleOpts{
+		TenantID:     d.Get("tenant_id").(string),
+		RuleType:     ruleType,
+		CompareType:  l7policies.CompareType(d.Get("compare_type").(string)),
+		Value:        d.Get("value").(string),
+		Key:          key,
+		Invert:       d.Get("invert").(bool),
+		AdminStateUp: &adminStateUp,
+	}
+
+	log.Printf("[DEBUG] Create Options: %#v", createOpts)
+
+	// Get the L7 Policy to find the parent load balancer
+	l7policyID := d.Get("l7policy_id").(string)
+	l7policy, err := l7policies.Get(lbClient, l7policyID).Extract()
+	if err != nil {
+		return fmt.Errorf("Unable to retrieve l7policy %s: %s", l7policyID, err)
+	}
+
+	lbID, err := getLBV2LoadBalancerIDByListener(lbClient, l7policy.ListenerID)
+	if err != nil {
+		return err
+	}
+
+	// Wait for LoadBalancer to become active before continuing
+	timeout := d.Timeout(schema.TimeoutCreate)
+	err = waitForLBV2LoadBalancer(lbClient, lbID, "ACTIVE", nil, timeout)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Attempting to create l7rule")
+	var l7rule *l7policies.Rule
+	err = resource.Retry(timeout, func() *resource.RetryError {
+		l7rule, err = l7policies.CreateRule(lbClient, l7policyID, createOpts).Extract()
+		if err != nil {
+			return checkForRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("Error creating l7rule: %s", err)
+	}
+
+	// Wait for LoadBalancer to become active again before continuing
+	err = waitForLBV2LoadBalancer(lbClient, lbID, "ACTIVE", nil, timeout)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(l7rule.ID)
+	d.Set("listener_id", l7policy.ListenerID)
+
+	return resourceL7RuleV2Read(d, meta)
+}
+
+func resourceL7RuleV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	lbClient, err := chooseLBV2Client(d, config)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	l7policyID := d.Get("l7policy_id").(string)
+	l7rule, err := l7policies.GetRule(lbClient, l7policyID, d.Id()).Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "l7rule")
+	}
+
+	log.Printf("[DEBUG] Retrieved l7rule %s: %#v", d.Id(), l7rule)
+
+	d.Set("tenant_id", l7rule.TenantID)
+	d.Set("type", l7rule.RuleType)
+	d.Set("compare_type", l7rule.CompareType)
+	d.Set("value", l7rule.Value)
+	d.Set("key", l7rule.Key)
+	d.Set("invert", l7rule.Invert)
+	d.Set("admin_state_up", l7rule.AdminStateUp)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceL7RuleV2Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	lbClient, err := chooseLBV2Client(d, config)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	ruleType := l7policies.RuleType(d.Get("type").(string))
+	key := d.Get("key").(string)
+	if err := checkL7RuleV2Type(ruleType, key); err != nil {
+		return err
+	}
+
+	var updateOpts l7policies.UpdateRuleOpts
+	if d.HasChange("type") {
+		updateOpts.RuleType = ruleType
+	}
+	if d.HasChange("compare_type") {
+		updateOpts.CompareType = l7policies.CompareType(d.Get("compare_type").(string))
+	}
+	if d.HasChange("value") {
+		updateOpts.Value = d.Get("value").(string)
+	}
+	if d.HasChange("key") {
+		updateOpts.Key = &key
+	}
+	if d.HasChange("invert") {
+		invert := d.Get("invert").(bool)
+		updateOpts.Invert = &invert
+	}
+	if d.HasChange("admin_state_up") {
+		asu := d.Get("admin_state_up").(bool)
+		updateOpts.AdminStateUp = &asu
+	}
+
+	lbID, err := getLBV2LoadBalancerIDByListener(lbClient, d.Get("listener_id").(string))
+	if err != nil {
+		return err
+	}
+
+	// Wait for LoadBalancer to become active before continuing
+	timeout := d.Timeout(schema.TimeoutUpdate)
+	err = waitForLBV2LoadBalancer(lbClient, lbID, "ACTIVE", nil, timeout)
+	if err != nil {
+		return err
+	}
+
+	l7policyID := d.Get("l7policy_id").(string)
+	log.Printf("[DEBUG] Updating l7rule %s with options: %#v", d.Id(), updateOpts)
+	err = resource.Retry(timeout, func() *resource.RetryError {
+		_, err = l7policies.UpdateRule(lbClient, l7policyID, d.Id(), updateOpts).Extract()
+		if err != nil {
+			return checkForRetryableError(err)
+		}
+		return nil
+	})
+
+	if err != nil {
+		return fmt.Errorf("Error updating l7rule %s: %s", d.Id(), err)
+	}
+
+	// Wait for LoadBalancer to become active again before continuing
+	err = waitForLBV2LoadBalancer(lbClient, lbID, "ACTIVE", nil, timeout)
+	if err != nil {
+		return err
+	}
+
+	return resourceL7RuleV2Read(d, meta)
+}
+
+func resourceL7RuleV2Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	lbClient, err := chooseLBV2Client(d, config)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	lbID, err := getLBV2LoadBalancerIDByListener(lbClient, d.Get("listener_id").(string))
+	if err != nil {
+		return CheckDeleted(d, err, "Error retrieving listener")
+	}
+
+	// Wait for LoadBalancer to become active before continuing
+	timeout := d.Timeout(schema.TimeoutDelete)
+	err = waitForLBV2LoadBalancer(lbClient, lbID, "ACTIVE", nil, timeout)
+	if err != nil {
+		return err
+	}
+
+	l7policyID := d.Get("l7policy_id").(string)
+	log.Printf("[DEBUG] Deleting l7rule %s", d.Id())
+	err = resource.Retry(timeout, func() *resource.RetryError {
+		err = l7policies.DeleteRule(lbClient, l7policyID, d.Id()).ExtractErr()
+		if err != nil {
+			return checkForRetryableError(err)
+		}
+		return nil
+	})
+
+	if err != nil {
+		return CheckDeleted(d, err, "Error deleting l7rule")
+	}
+
+	// Wait for LoadBalancer to become active again before continuing
+	err = waitForLBV2LoadBalancer(lbClient, lbID, "ACTIVE", nil, timeout)
+	if err != nil {
+		return err
+	}
+
+	// Wait for L7 Rule to delete
+	err = waitForLBV2L7Rule(lbClient, l7policyID, d.Id(), "DELETED", nil, timeout)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func resourceL7RuleV2Import(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.SplitN(d.Id(), "/", 2)
+	if len(parts) != 2 {
+		err := fmt.Errorf("Invalid format specified for openstack_lb_l7rule_v2. Format must be <l7policy id>/<l7rule id>")
+		return nil, err
+	}
+
+	config := meta.(*Config)
+	lbClient, err := chooseLBV2Client(d, config)
+	if err != nil {
+		return nil, fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	l7policyID := parts[0]
+	l7ruleID := parts[1]
+
+	l7policy, err := l7policies.Get(lbClient, l7policyID).Extract()
+	if err != nil {
+		return nil, fmt.Errorf("Unable to retrieve l7policy %s: %s", l7policyID, err)
+	}
+
+	d.SetId(l7ruleID)
+	d.Set("l7policy_id", l7policyID)
+	d.Set("listener_id", l7policy.ListenerID)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// checkL7RuleV2Type ensures that the key is only set, and always set, for
+// the rule types which compare a named cookie or header.
+func checkL7RuleV2Type(ruleType l7policies.RuleType, key string) error {
+	switch ruleType {
+	case l7policies.TypeCookie, l7policies.TypeHeader:
+		if key == "" {
+			return fmt.Errorf("key must be set when type is %s", ruleType)
+		}
+	default:
+		if key != "" {
+			return fmt.Errorf("key can't be set when type is %s", ruleType)
+		}
+	}
+
+	return nil
+}
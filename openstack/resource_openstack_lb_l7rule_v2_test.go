@@ -0,0 +1,201 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/samuelbernardolip/gophercloud/openstack/networking/v2/extensions/lbaas_v2/l7policies"
+)
+
+func TestAccLBV2L7Rule_basic(t *testing.T) {
+	var l7rule l7policies.Rule
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckLB(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckLBV2L7RuleDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: TestAccLBV2L7RuleConfig_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLBV2L7RuleExists("openstack_lb_l7rule_v2.l7rule_1", &l7rule),
+					resource.TestCheckResourceAttr(
+						"openstack_lb_l7rule_v2.l7rule_1", "type", "PATH"),
+					resource.TestCheckResourceAttr(
+						"openstack_lb_l7rule_v2.l7rule_1", "compare_type", "EQUAL_TO"),
+					resource.TestCheckResourceAttr(
+						"openstack_lb_l7rule_v2.l7rule_1", "value", "/api"),
+					resource.TestCheckResourceAttrPair(
+						"openstack_lb_l7rule_v2.l7rule_1", "listener_id",
+						"openstack_lb_listener_v2.listener_1", "id"),
+				),
+			},
+			resource.TestStep{
+				Config: TestAccLBV2L7RuleConfig_update,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"openstack_lb_l7rule_v2.l7rule_1", "type", "HEADER"),
+					resource.TestCheckResourceAttr(
+						"openstack_lb_l7rule_v2.l7rule_1", "compare_type", "STARTS_WITH"),
+					resource.TestCheckResourceAttr(
+						"openstack_lb_l7rule_v2.l7rule_1", "key", "X-Ref"),
+					resource.TestCheckResourceAttr(
+						"openstack_lb_l7rule_v2.l7rule_1", "value", "foo"),
+					resource.TestCheckResourceAttr(
+						"openstack_lb_l7rule_v2.l7rule_1", "invert", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckLBV2L7RuleDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	lbClient, err := chooseLBV2AccTestClient(config, OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack load balancing client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "openstack_lb_l7rule_v2" {
+			continue
+		}
+
+		_, err := l7policies.GetRule(lbClient, rs.Primary.Attributes["l7policy_id"], rs.Primary.ID).Extract()
+		if err == nil {
+			return fmt.Errorf("L7 Rule still exists: %s", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckLBV2L7RuleExists(n string, l7rule *l7policies.Rule) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		lbClient, err := chooseLBV2AccTestClient(config, OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack load balancing client: %s", err)
+		}
+
+		found, err := l7policies.GetRule(lbClient, rs.Primary.Attributes["l7policy_id"], rs.Primary.ID).Extract()
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return fmt.Errorf("L7 Rule not found")
+		}
+
+		*l7rule = *found
+
+		return nil
+	}
+}
+
+const TestAccLBV2L7RuleConfig_basic = `
+resource "openstack_networking_network_v2" "network_1" {
+  name = "network_1"
+  admin_state_up = "true"
+}
+
+resource "openstack_networking_subnet_v2" "subnet_1" {
+  name = "subnet_1"
+  cidr = "192.168.199.0/24"
+  ip_version = 4
+  network_id = "${openstack_networking_network_v2.network_1.id}"
+}
+
+resource "openstack_lb_loadbalancer_v2" "loadbalancer_1" {
+  name = "loadbalancer_1"
+  vip_subnet_id = "${openstack_networking_subnet_v2.subnet_1.id}"
+}
+
+resource "openstack_lb_listener_v2" "listener_1" {
+  name = "listener_1"
+  protocol = "HTTP"
+  protocol_port = 8080
+  loadbalancer_id = "${openstack_lb_loadbalancer_v2.loadbalancer_1.id}"
+}
+
+resource "openstack_lb_pool_v2" "pool_1" {
+  name = "pool_1"
+  protocol = "HTTP"
+  lb_method = "ROUND_ROBIN"
+  loadbalancer_id = "${openstack_lb_loadbalancer_v2.loadbalancer_1.id}"
+}
+
+resource "openstack_lb_l7policy_v2" "l7policy_1" {
+  name = "test"
+  action = "REDIRECT_TO_POOL"
+  listener_id = "${openstack_lb_listener_v2.listener_1.id}"
+  redirect_pool_id = "${openstack_lb_pool_v2.pool_1.id}"
+}
+
+resource "openstack_lb_l7rule_v2" "l7rule_1" {
+  l7policy_id = "${openstack_lb_l7policy_v2.l7policy_1.id}"
+  type = "PATH"
+  compare_type = "EQUAL_TO"
+  value = "/api"
+}
+`
+
+const TestAccLBV2L7RuleConfig_update = `
+resource "openstack_networking_network_v2" "network_1" {
+  name = "network_1"
+  admin_state_up = "true"
+}
+
+resource "openstack_networking_subnet_v2" "subnet_1" {
+  name = "subnet_1"
+  cidr = "192.168.199.0/24"
+  ip_version = 4
+  network_id = "${openstack_networking_network_v2.network_1.id}"
+}
+
+resource "openstack_lb_loadbalancer_v2" "loadbalancer_1" {
+  name = "loadbalancer_1"
+  vip_subnet_id = "${openstack_networking_subnet_v2.subnet_1.id}"
+}
+
+resource "openstack_lb_listener_v2" "listener_1" {
+  name = "listener_1"
+  protocol = "HTTP"
+  protocol_port = 8080
+  loadbalancer_id = "${openstack_lb_loadbalancer_v2.loadbalancer_1.id}"
+}
+
+resource "openstack_lb_pool_v2" "pool_1" {
+  name = "pool_1"
+  protocol = "HTTP"
+  lb_method = "ROUND_ROBIN"
+  loadbalancer_id = "${openstack_lb_loadbalancer_v2.loadbalancer_1.id}"
+}
+
+resource "openstack_lb_l7policy_v2" "l7policy_1" {
+  name = "test"
+  action = "REDIRECT_TO_POOL"
+  listener_id = "${openstack_lb_listener_v2.listener_1.id}"
+  redirect_pool_id = "${openstack_lb_pool_v2.pool_1.id}"
+}
+
+resource "openstack_lb_l7rule_v2" "l7rule_1" {
+  l7policy_id = "${openstack_lb_l7policy_v2.l7policy_1.id}"
+  type = "HEADER"
+  compare_type = "STARTS_WITH"
+  key = "X-Ref"
+  value = "foo"
+  invert = true
+}
+`
@@ -0,0 +1,159 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+
+	"github.com/samuelbernardolip/gophercloud/openstack/networking/v2/extensions/lbaas_v2/quotas"
+)
+
+func resourceLBQuotaV2() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceLBQuotaV2Create,
+		Read:   resourceLBQuotaV2Read,
+		Update: resourceLBQuotaV2Update,
+		Delete: resourceLBQuotaV2Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"project_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"loadbalancer": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+
+			"listener": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+
+			"member": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+
+			"pool": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+
+			"health_monitor": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceLBQuotaV2Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	lbClient, err := config.loadBalancerV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack load balancer client: %s", err)
+	}
+
+	projectID := d.Get("project_id").(string)
+	updateOpts := quotas.UpdateOpts{
+		Loadbalancer:  quotaValue(d, "loadbalancer"),
+		Listener:      quotaValue(d, "listener"),
+		Member:        quotaValue(d, "member"),
+		Pool:          quotaValue(d, "pool"),
+		Healthmonitor: quotaValue(d, "health_monitor"),
+	}
+
+	log.Printf("[DEBUG] openstack_lb_quota_v2 create options: %#v", updateOpts)
+	_, err = quotas.Update(lbClient, projectID, updateOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error creating openstack_lb_quota_v2 for project %s: %s", projectID, err)
+	}
+
+	d.SetId(projectID)
+
+	return resourceLBQuotaV2Read(d, meta)
+}
+
+func resourceLBQuotaV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	lbClient, err := config.loadBalancerV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack load balancer client: %s", err)
+	}
+
+	q, err := quotas.Get(lbClient, d.Id()).Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "openstack_lb_quota_v2")
+	}
+
+	log.Printf("[DEBUG] Retrieved openstack_lb_quota_v2 %s: %#v", d.Id(), q)
+
+	d.Set("project_id", d.Id())
+	d.Set("region", GetRegion(d, config))
+	d.Set("loadbalancer", q.Loadbalancer)
+	d.Set("listener", q.Listener)
+	d.Set("member", q.Member)
+	d.Set("pool", q.Pool)
+	d.Set("health_monitor", q.Healthmonitor)
+
+	return nil
+}
+
+func resourceLBQuotaV2Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	lbClient, err := config.loadBalancerV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack load balancer client: %s", err)
+	}
+
+	updateOpts := quotas.UpdateOpts{
+		Loadbalancer:  quotaChangedValue(d, "loadbalancer"),
+		Listener:      quotaChangedValue(d, "listener"),
+		Member:        quotaChangedValue(d, "member"),
+		Pool:          quotaChangedValue(d, "pool"),
+		Healthmonitor: quotaChangedValue(d, "health_monitor"),
+	}
+
+	log.Printf("[DEBUG] openstack_lb_quota_v2 %s update options: %#v", d.Id(), updateOpts)
+	_, err = quotas.Update(lbClient, d.Id(), updateOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error updating openstack_lb_quota_v2 %s: %s", d.Id(), err)
+	}
+
+	return resourceLBQuotaV2Read(d, meta)
+}
+
+func resourceLBQuotaV2Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	lbClient, err := config.loadBalancerV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack load balancer client: %s", err)
+	}
+
+	log.Printf("[DEBUG] Restoring default quotas for openstack_lb_quota_v2 %s", d.Id())
+	err = quotas.Delete(lbClient, d.Id()).ExtractErr()
+	if err != nil {
+		return CheckDeleted(d, err, "Error restoring default quotas for openstack_lb_quota_v2")
+	}
+
+	return nil
+}
@@ -0,0 +1,86 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccLBV2Quota_basic(t *testing.T) {
+	projectName := fmt.Sprintf("tf_test_%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckLB(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIdentityV3ProjectDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccLBV2Quota_basic(projectName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"openstack_lb_quota_v2.quota_1", "project_id",
+						"openstack_identity_project_v3.project_1", "id"),
+					resource.TestCheckResourceAttr(
+						"openstack_lb_quota_v2.quota_1", "loadbalancer", "2"),
+					resource.TestCheckResourceAttr(
+						"openstack_lb_quota_v2.quota_1", "listener", "4"),
+					resource.TestCheckResourceAttr(
+						"openstack_lb_quota_v2.quota_1", "pool", "4"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccLBV2Quota_update(projectName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"openstack_lb_quota_v2.quota_1", "project_id",
+						"openstack_identity_project_v3.project_1", "id"),
+					resource.TestCheckResourceAttr(
+						"openstack_lb_quota_v2.quota_1", "loadbalancer", "4"),
+					resource.TestCheckResourceAttr(
+						"openstack_lb_quota_v2.quota_1", "listener", "8"),
+					resource.TestCheckResourceAttr(
+						"openstack_lb_quota_v2.quota_1", "pool", "8"),
+					resource.TestCheckResourceAttr(
+						"openstack_lb_quota_v2.quota_1", "health_monitor", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccLBV2Quota_basic(projectName string) string {
+	return fmt.Sprintf(`
+resource "openstack_identity_project_v3" "project_1" {
+  name = "%s"
+}
+
+resource "openstack_lb_quota_v2" "quota_1" {
+  project_id   = "${openstack_identity_project_v3.project_1.id}"
+  loadbalancer = 2
+  listener     = 4
+  pool         = 4
+}
+`, projectName)
+}
+
+func testAccLBV2Quota_update(projectName string) string {
+	return fmt.Sprintf(`
+resource "openstack_identity_project_v3" "project_1" {
+  name = "%s"
+}
+
+resource "openstack_lb_quota_v2" "quota_1" {
+  project_id     = "${openstack_identity_project_v3.project_1.id}"
+  loadbalancer   = 4
+  listener       = 8
+  pool           = 8
+  health_monitor = 2
+}
+`, projectName)
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/samuelbernardolip/gophercloud/openstack/networking/v2/extensions/attributestags"
 	"github.com/samuelbernardolip/gophercloud/openstack/networking/v2/extensions/external"
 	"github.com/samuelbernardolip/gophercloud/openstack/networking/v2/extensions/provider"
+	"github.com/samuelbernardolip/gophercloud/openstack/networking/v2/extensions/qos/policies"
 	"github.com/samuelbernardolip/gophercloud/openstack/networking/v2/networks"
 )
 
@@ -108,6 +109,12 @@ func resourceNetworkingNetworkV2() *schema.Resource {
 				Optional: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
+			"qos_policy_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: false,
+				Computed: true,
+			},
 		},
 	}
 }
@@ -146,13 +153,25 @@ func resourceNetworkingNetworkV2Create(d *schema.ResourceData, meta interface{})
 		createOpts.Shared = &shared
 	}
 
+	// Declare a finalCreateOpts interface to hold either the
+	// base create options or the QoS create options.
+	var finalCreateOpts networks.CreateOptsBuilder
+	finalCreateOpts = createOpts
+
+	if qosPolicyID := d.Get("qos_policy_id").(string); qosPolicyID != "" {
+		finalCreateOpts = policies.NetworkCreateOptsExt{
+			CreateOptsBuilder: createOpts,
+			QoSPolicyID:       qosPolicyID,
+		}
+	}
+
 	segments := resourceNetworkingNetworkV2Segments(d)
 
 	isExternal := d.Get("external").(bool)
 	n := &networks.Network{}
 	if len(segments) > 0 {
 		providerCreateOpts := provider.CreateOptsExt{
-			CreateOptsBuilder: createOpts,
+			CreateOptsBuilder: finalCreateOpts,
 			Segments:          segments,
 		}
 		if isExternal {
@@ -169,14 +188,14 @@ func resourceNetworkingNetworkV2Create(d *schema.ResourceData, meta interface{})
 	} else {
 		if isExternal {
 			createExternalOpts := external.CreateOptsExt{
-				CreateOptsBuilder: createOpts,
+				CreateOptsBuilder: finalCreateOpts,
 				External:          &isExternal,
 			}
 			log.Printf("[DEBUG] Create Options: %#v", createExternalOpts)
 			n, err = networks.Create(networkingClient, createExternalOpts).Extract()
 		} else {
-			log.Printf("[DEBUG] Create Options: %#v", createOpts)
-			n, err = networks.Create(networkingClient, createOpts).Extract()
+			log.Printf("[DEBUG] Create Options: %#v", finalCreateOpts)
+			n, err = networks.Create(networkingClient, finalCreateOpts).Extract()
 		}
 	}
 
@@ -224,6 +243,7 @@ func resourceNetworkingNetworkV2Read(d *schema.ResourceData, meta interface{}) e
 	var n struct {
 		networks.Network
 		external.NetworkExternalExt
+		policies.QoSPolicyExt
 	}
 	err = networks.Get(networkingClient, d.Id()).ExtractInto(&n)
 	if err != nil {
@@ -239,6 +259,7 @@ func resourceNetworkingNetworkV2Read(d *schema.ResourceData, meta interface{}) e
 	d.Set("tenant_id", n.TenantID)
 	d.Set("region", GetRegion(d, config))
 	d.Set("tags", n.Tags)
+	d.Set("qos_policy_id", n.QoSPolicyID)
 
 	if err := d.Set("availability_zone_hints", n.AvailabilityZoneHints); err != nil {
 		log.Printf("[DEBUG] unable to set availability_zone_hints: %s", err)
@@ -292,16 +313,29 @@ func resourceNetworkingNetworkV2Update(d *schema.ResourceData, meta interface{})
 		isExternal = d.Get("external").(bool)
 	}
 
+	// Declare a finalUpdateOpts interface to hold either the
+	// base update options or the QoS update options.
+	var finalUpdateOpts networks.UpdateOptsBuilder
+	finalUpdateOpts = updateOpts
+
+	if d.HasChange("qos_policy_id") {
+		qosPolicyID := d.Get("qos_policy_id").(string)
+		finalUpdateOpts = policies.NetworkUpdateOptsExt{
+			UpdateOptsBuilder: updateOpts,
+			QoSPolicyID:       &qosPolicyID,
+		}
+	}
+
 	if isExternal {
 		externalUpdateOpts := external.UpdateOptsExt{
-			UpdateOptsBuilder: updateOpts,
+			UpdateOptsBuilder: finalUpdateOpts,
 			External:          &isExternal,
 		}
 		log.Printf("[DEBUG] Updating Network %s with options: %+v", d.Id(), externalUpdateOpts)
 		_, err = networks.Update(networkingClient, d.Id(), externalUpdateOpts).Extract()
 	} else {
-		log.Printf("[DEBUG] Updating Network %s with options: %+v", d.Id(), updateOpts)
-		_, err = networks.Update(networkingClient, d.Id(), updateOpts).Extract()
+		log.Printf("[DEBUG] Updating Network %s with options: %+v", d.Id(), finalUpdateOpts)
+		_, err = networks.Update(networkingClient, d.Id(), finalUpdateOpts).Extract()
 	}
 
 	if err != nil {
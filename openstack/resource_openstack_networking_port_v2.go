@@ -12,6 +12,7 @@ import (
 	"github.com/samuelbernardolip/gophercloud"
 	"github.com/samuelbernardolip/gophercloud/openstack/networking/v2/extensions/attributestags"
 	"github.com/samuelbernardolip/gophercloud/openstack/networking/v2/extensions/extradhcpopts"
+	"github.com/samuelbernardolip/gophercloud/openstack/networking/v2/extensions/qos/policies"
 	"github.com/samuelbernardolip/gophercloud/openstack/networking/v2/ports"
 )
 
@@ -158,6 +159,11 @@ func resourceNetworkingPortV2() *schema.Resource {
 				Optional: true,
 				ForceNew: true,
 			},
+			"qos_policy_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
 			"all_fixed_ips": &schema.Schema{
 				Type:     schema.TypeList,
 				Computed: true,
@@ -223,7 +229,7 @@ func resourceNetworkingPortV2Create(d *schema.ResourceData, meta interface{}) er
 	}
 
 	// Declare a finalCreateOpts interface to hold either the
-	// base create options or the extended dhcp options.
+	// base create options or the extended dhcp and QoS options.
 	var finalCreateOpts ports.CreateOptsBuilder
 	finalCreateOpts = createOpts
 
@@ -235,12 +241,20 @@ func resourceNetworkingPortV2Create(d *schema.ResourceData, meta interface{}) er
 		}
 	}
 
+	if qosPolicyID := d.Get("qos_policy_id").(string); qosPolicyID != "" {
+		finalCreateOpts = policies.PortCreateOptsExt{
+			CreateOptsBuilder: finalCreateOpts,
+			QoSPolicyID:       qosPolicyID,
+		}
+	}
+
 	log.Printf("[DEBUG] Create Options: %#v", finalCreateOpts)
 
 	// Create a Neutron port and set extra DHCP options if they're specified.
 	var p struct {
 		ports.Port
 		extradhcpopts.ExtraDHCPOptsExt
+		policies.QoSPolicyExt
 	}
 
 	err = ports.Create(networkingClient, finalCreateOpts).ExtractInto(&p)
@@ -287,6 +301,7 @@ func resourceNetworkingPortV2Read(d *schema.ResourceData, meta interface{}) erro
 	var p struct {
 		ports.Port
 		extradhcpopts.ExtraDHCPOptsExt
+		policies.QoSPolicyExt
 	}
 	err = ports.Get(networkingClient, d.Id()).ExtractInto(&p)
 	if err != nil {
@@ -303,6 +318,7 @@ func resourceNetworkingPortV2Read(d *schema.ResourceData, meta interface{}) erro
 	d.Set("device_owner", p.DeviceOwner)
 	d.Set("device_id", p.DeviceID)
 	d.Set("tags", p.Tags)
+	d.Set("qos_policy_id", p.QoSPolicyID)
 
 	// Create a slice of all returned Fixed IPs.
 	// This will be in the order returned by the API,
@@ -395,9 +411,24 @@ func resourceNetworkingPortV2Update(d *schema.ResourceData, meta interface{}) er
 		updateOpts.FixedIPs = resourcePortFixedIpsV2(d)
 	}
 
+	// Declare a finalUpdateOpts interface to hold either the
+	// base update options or the QoS update options.
+	var finalUpdateOpts ports.UpdateOptsBuilder
+	finalUpdateOpts = updateOpts
+
+	if d.HasChange("qos_policy_id") {
+		hasChange = true
+		qosPolicyID := d.Get("qos_policy_id").(string)
+		finalUpdateOpts = policies.PortUpdateOptsExt{
+			UpdateOptsBuilder: updateOpts,
+			QoSPolicyID:       &qosPolicyID,
+		}
+	}
+
 	// At this point, perform the update for all "standard" port changes.
 	if hasChange {
-		_, err = ports.Update(networkingClient, d.Id(), updateOpts).Extract()
+		log.Printf("[DEBUG] Updating Port %s with options: %+v", d.Id(), finalUpdateOpts)
+		_, err = ports.Update(networkingClient, d.Id(), finalUpdateOpts).Extract()
 		if err != nil {
 			return fmt.Errorf("Error updating OpenStack Neutron Port: %s", err)
 		}
@@ -0,0 +1,169 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+
+	"github.com/samuelbernardolip/gophercloud/openstack/networking/v2/extensions/qos/rules"
+)
+
+func resourceNetworkingQoSBandwidthLimitRuleV2() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetworkingQoSBandwidthLimitRuleV2Create,
+		Read:   resourceNetworkingQoSBandwidthLimitRuleV2Read,
+		Update: resourceNetworkingQoSBandwidthLimitRuleV2Update,
+		Delete: resourceNetworkingQoSBandwidthLimitRuleV2Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"qos_policy_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"max_kbps": &schema.Schema{
+				Type:     schema.TypeInt,
+				Required: true,
+				ForceNew: false,
+			},
+			"max_burst_kbps": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+				ForceNew: false,
+			},
+			"direction": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "egress",
+				ForceNew: false,
+				ValidateFunc: validation.StringInSlice([]string{
+					"egress", "ingress",
+				}, false),
+			},
+		},
+	}
+}
+
+func resourceNetworkingQoSBandwidthLimitRuleV2Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	createOpts := rules.CreateBandwidthLimitRuleOpts{
+		MaxKBps:      d.Get("max_kbps").(int),
+		MaxBurstKBps: d.Get("max_burst_kbps").(int),
+		Direction:    d.Get("direction").(string),
+	}
+	qosPolicyID := d.Get("qos_policy_id").(string)
+
+	log.Printf("[DEBUG] Create Options: %#v", createOpts)
+
+	r, err := rules.CreateBandwidthLimitRule(networkingClient, qosPolicyID, createOpts).ExtractBandwidthLimitRule()
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack Neutron QoS bandwidth limit rule: %s", err)
+	}
+
+	d.SetId(networkingQoSRuleV2ID(qosPolicyID, r.ID))
+
+	log.Printf("[DEBUG] Created QoS bandwidth limit rule %s: %#v", d.Id(), r)
+	return resourceNetworkingQoSBandwidthLimitRuleV2Read(d, meta)
+}
+
+func resourceNetworkingQoSBandwidthLimitRuleV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	qosPolicyID, qosRuleID, err := parseNetworkingQoSRuleV2ID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	r, err := rules.GetBandwidthLimitRule(networkingClient, qosPolicyID, qosRuleID).ExtractBandwidthLimitRule()
+	if err != nil {
+		return CheckDeleted(d, err, "QoS bandwidth limit rule")
+	}
+
+	log.Printf("[DEBUG] Retrieved QoS bandwidth limit rule %s: %#v", d.Id(), r)
+
+	d.Set("qos_policy_id", qosPolicyID)
+	d.Set("max_kbps", r.MaxKBps)
+	d.Set("max_burst_kbps", r.MaxBurstKBps)
+	d.Set("direction", r.Direction)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceNetworkingQoSBandwidthLimitRuleV2Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	qosPolicyID, qosRuleID, err := parseNetworkingQoSRuleV2ID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	var updateOpts rules.UpdateBandwidthLimitRuleOpts
+
+	if d.HasChange("max_kbps") {
+		v := d.Get("max_kbps").(int)
+		updateOpts.MaxKBps = &v
+	}
+
+	if d.HasChange("max_burst_kbps") {
+		v := d.Get("max_burst_kbps").(int)
+		updateOpts.MaxBurstKBps = &v
+	}
+
+	if d.HasChange("direction") {
+		updateOpts.Direction = d.Get("direction").(string)
+	}
+
+	log.Printf("[DEBUG] Updating QoS bandwidth limit rule %s with options: %+v", d.Id(), updateOpts)
+
+	_, err = rules.UpdateBandwidthLimitRule(networkingClient, qosPolicyID, qosRuleID, updateOpts).ExtractBandwidthLimitRule()
+	if err != nil {
+		return fmt.Errorf("Error updating OpenStack Neutron QoS bandwidth limit rule: %s", err)
+	}
+
+	return resourceNetworkingQoSBandwidthLimitRuleV2Read(d, meta)
+}
+
+func resourceNetworkingQoSBandwidthLimitRuleV2Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	qosPolicyID, qosRuleID, err := parseNetworkingQoSRuleV2ID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	err = rules.DeleteBandwidthLimitRule(networkingClient, qosPolicyID, qosRuleID).ExtractErr()
+	if err != nil {
+		return CheckDeleted(d, err, "Error deleting OpenStack Neutron QoS bandwidth limit rule")
+	}
+
+	return nil
+}
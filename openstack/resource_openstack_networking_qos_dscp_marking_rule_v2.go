@@ -0,0 +1,141 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+
+	"github.com/samuelbernardolip/gophercloud/openstack/networking/v2/extensions/qos/rules"
+)
+
+func resourceNetworkingQoSDSCPMarkingRuleV2() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetworkingQoSDSCPMarkingRuleV2Create,
+		Read:   resourceNetworkingQoSDSCPMarkingRuleV2Read,
+		Update: resourceNetworkingQoSDSCPMarkingRuleV2Update,
+		Delete: resourceNetworkingQoSDSCPMarkingRuleV2Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"qos_policy_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"dscp_mark": &schema.Schema{
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     false,
+				ValidateFunc: validateNetworkingQoSRuleV2DSCPMark,
+			},
+		},
+	}
+}
+
+func resourceNetworkingQoSDSCPMarkingRuleV2Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	createOpts := rules.CreateDSCPMarkingRuleOpts{
+		DSCPMark: d.Get("dscp_mark").(int),
+	}
+	qosPolicyID := d.Get("qos_policy_id").(string)
+
+	log.Printf("[DEBUG] Create Options: %#v", createOpts)
+
+	r, err := rules.CreateDSCPMarkingRule(networkingClient, qosPolicyID, createOpts).ExtractDSCPMarkingRule()
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack Neutron QoS DSCP marking rule: %s", err)
+	}
+
+	d.SetId(networkingQoSRuleV2ID(qosPolicyID, r.ID))
+
+	log.Printf("[DEBUG] Created QoS DSCP marking rule %s: %#v", d.Id(), r)
+	return resourceNetworkingQoSDSCPMarkingRuleV2Read(d, meta)
+}
+
+func resourceNetworkingQoSDSCPMarkingRuleV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	qosPolicyID, qosRuleID, err := parseNetworkingQoSRuleV2ID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	r, err := rules.GetDSCPMarkingRule(networkingClient, qosPolicyID, qosRuleID).ExtractDSCPMarkingRule()
+	if err != nil {
+		return CheckDeleted(d, err, "QoS DSCP marking rule")
+	}
+
+	log.Printf("[DEBUG] Retrieved QoS DSCP marking rule %s: %#v", d.Id(), r)
+
+	d.Set("qos_policy_id", qosPolicyID)
+	d.Set("dscp_mark", r.DSCPMark)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceNetworkingQoSDSCPMarkingRuleV2Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	qosPolicyID, qosRuleID, err := parseNetworkingQoSRuleV2ID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	var updateOpts rules.UpdateDSCPMarkingRuleOpts
+
+	if d.HasChange("dscp_mark") {
+		v := d.Get("dscp_mark").(int)
+		updateOpts.DSCPMark = &v
+	}
+
+	log.Printf("[DEBUG] Updating QoS DSCP marking rule %s with options: %+v", d.Id(), updateOpts)
+
+	_, err = rules.UpdateDSCPMarkingRule(networkingClient, qosPolicyID, qosRuleID, updateOpts).ExtractDSCPMarkingRule()
+	if err != nil {
+		return fmt.Errorf("Error updating OpenStack Neutron QoS DSCP marking rule: %s", err)
+	}
+
+	return resourceNetworkingQoSDSCPMarkingRuleV2Read(d, meta)
+}
+
+func resourceNetworkingQoSDSCPMarkingRuleV2Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	qosPolicyID, qosRuleID, err := parseNetworkingQoSRuleV2ID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	err = rules.DeleteDSCPMarkingRule(networkingClient, qosPolicyID, qosRuleID).ExtractErr()
+	if err != nil {
+		return CheckDeleted(d, err, "Error deleting OpenStack Neutron QoS DSCP marking rule")
+	}
+
+	return nil
+}
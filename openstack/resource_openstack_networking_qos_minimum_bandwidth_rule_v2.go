@@ -0,0 +1,156 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+
+	"github.com/samuelbernardolip/gophercloud/openstack/networking/v2/extensions/qos/rules"
+)
+
+func resourceNetworkingQoSMinimumBandwidthRuleV2() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetworkingQoSMinimumBandwidthRuleV2Create,
+		Read:   resourceNetworkingQoSMinimumBandwidthRuleV2Read,
+		Update: resourceNetworkingQoSMinimumBandwidthRuleV2Update,
+		Delete: resourceNetworkingQoSMinimumBandwidthRuleV2Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"qos_policy_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"min_kbps": &schema.Schema{
+				Type:     schema.TypeInt,
+				Required: true,
+				ForceNew: false,
+			},
+			"direction": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "egress",
+				ForceNew: false,
+				ValidateFunc: validation.StringInSlice([]string{
+					"egress", "ingress",
+				}, false),
+			},
+		},
+	}
+}
+
+func resourceNetworkingQoSMinimumBandwidthRuleV2Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	createOpts := rules.CreateMinimumBandwidthRuleOpts{
+		MinKBps:   d.Get("min_kbps").(int),
+		Direction: d.Get("direction").(string),
+	}
+	qosPolicyID := d.Get("qos_policy_id").(string)
+
+	log.Printf("[DEBUG] Create Options: %#v", createOpts)
+
+	r, err := rules.CreateMinimumBandwidthRule(networkingClient, qosPolicyID, createOpts).ExtractMinimumBandwidthRule()
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack Neutron QoS minimum bandwidth rule: %s", err)
+	}
+
+	d.SetId(networkingQoSRuleV2ID(qosPolicyID, r.ID))
+
+	log.Printf("[DEBUG] Created QoS minimum bandwidth rule %s: %#v", d.Id(), r)
+	return resourceNetworkingQoSMinimumBandwidthRuleV2Read(d, meta)
+}
+
+func resourceNetworkingQoSMinimumBandwidthRuleV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	qosPolicyID, qosRuleID, err := parseNetworkingQoSRuleV2ID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	r, err := rules.GetMinimumBandwidthRule(networkingClient, qosPolicyID, qosRuleID).ExtractMinimumBandwidthRule()
+	if err != nil {
+		return CheckDeleted(d, err, "QoS minimum bandwidth rule")
+	}
+
+	log.Printf("[DEBUG] Retrieved QoS minimum bandwidth rule %s: %#v", d.Id(), r)
+
+	d.Set("qos_policy_id", qosPolicyID)
+	d.Set("min_kbps", r.MinKBps)
+	d.Set("direction", r.Direction)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceNetworkingQoSMinimumBandwidthRuleV2Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	qosPolicyID, qosRuleID, err := parseNetworkingQoSRuleV2ID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	var updateOpts rules.UpdateMinimumBandwidthRuleOpts
+
+	if d.HasChange("min_kbps") {
+		v := d.Get("min_kbps").(int)
+		updateOpts.MinKBps = &v
+	}
+
+	if d.HasChange("direction") {
+		updateOpts.Direction = d.Get("direction").(string)
+	}
+
+	log.Printf("[DEBUG] Updating QoS minimum bandwidth rule %s with options: %+v", d.Id(), updateOpts)
+
+	_, err = rules.UpdateMinimumBandwidthRule(networkingClient, qosPolicyID, qosRuleID, updateOpts).ExtractMinimumBandwidthRule()
+	if err != nil {
+		return fmt.Errorf("Error updating OpenStack Neutron QoS minimum bandwidth rule: %s", err)
+	}
+
+	return resourceNetworkingQoSMinimumBandwidthRuleV2Read(d, meta)
+}
+
+func resourceNetworkingQoSMinimumBandwidthRuleV2Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	qosPolicyID, qosRuleID, err := parseNetworkingQoSRuleV2ID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	err = rules.DeleteMinimumBandwidthRule(networkingClient, qosPolicyID, qosRuleID).ExtractErr()
+	if err != nil {
+		return CheckDeleted(d, err, "Error deleting OpenStack Neutron QoS minimum bandwidth rule")
+	}
+
+	return nil
+}
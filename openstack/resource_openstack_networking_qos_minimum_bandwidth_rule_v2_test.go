@@ -0,0 +1,128 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+
+	"github.com/samuelbernardolip/gophercloud/openstack/networking/v2/extensions/qos/rules"
+)
+
+func TestAccNetworkingV2QoSMinimumBandwidthRuleBasic(t *testing.T) {
+	var rule rules.MinimumBandwidthRule
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNetworkingV2QoSMinimumBandwidthRuleDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccNetworkingV2QoSMinimumBandwidthRuleBasic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingV2QoSMinimumBandwidthRuleExists("openstack_networking_qos_minimum_bandwidth_rule_v2.minimum_bandwidth_rule_1", &rule),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_qos_minimum_bandwidth_rule_v2.minimum_bandwidth_rule_1", "min_kbps", "3000"),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_qos_minimum_bandwidth_rule_v2.minimum_bandwidth_rule_1", "direction", "egress"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccNetworkingV2QoSMinimumBandwidthRuleUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"openstack_networking_qos_minimum_bandwidth_rule_v2.minimum_bandwidth_rule_1", "min_kbps", "2000"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckNetworkingV2QoSMinimumBandwidthRuleExists(n string, rule *rules.MinimumBandwidthRule) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		networkingClient, err := config.networkingV2Client(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+		}
+
+		qosPolicyID, qosRuleID, err := parseNetworkingQoSRuleV2ID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		found, err := rules.GetMinimumBandwidthRule(networkingClient, qosPolicyID, qosRuleID).ExtractMinimumBandwidthRule()
+		if err != nil {
+			return err
+		}
+
+		if found.ID != qosRuleID {
+			return fmt.Errorf("QoS minimum bandwidth rule not found")
+		}
+
+		*rule = *found
+
+		return nil
+	}
+}
+
+func testAccCheckNetworkingV2QoSMinimumBandwidthRuleDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	networkingClient, err := config.networkingV2Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "openstack_networking_qos_minimum_bandwidth_rule_v2" {
+			continue
+		}
+
+		qosPolicyID, qosRuleID, err := parseNetworkingQoSRuleV2ID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		_, err = rules.GetMinimumBandwidthRule(networkingClient, qosPolicyID, qosRuleID).ExtractMinimumBandwidthRule()
+		if err == nil {
+			return fmt.Errorf("QoS minimum bandwidth rule still exists")
+		}
+	}
+
+	return nil
+}
+
+const testAccNetworkingV2QoSMinimumBandwidthRuleBasic = `
+resource "openstack_networking_qos_policy_v2" "qos_policy_1" {
+  name = "qos_policy_1"
+}
+
+resource "openstack_networking_qos_minimum_bandwidth_rule_v2" "minimum_bandwidth_rule_1" {
+  qos_policy_id = "${openstack_networking_qos_policy_v2.qos_policy_1.id}"
+  min_kbps = 3000
+}
+`
+
+const testAccNetworkingV2QoSMinimumBandwidthRuleUpdate = `
+resource "openstack_networking_qos_policy_v2" "qos_policy_1" {
+  name = "qos_policy_1"
+}
+
+resource "openstack_networking_qos_minimum_bandwidth_rule_v2" "minimum_bandwidth_rule_1" {
+  qos_policy_id = "${openstack_networking_qos_policy_v2.qos_policy_1.id}"
+  min_kbps = 2000
+}
+`
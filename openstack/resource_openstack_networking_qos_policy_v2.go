@@ -0,0 +1,275 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+
+	"github.com/samuelbernardolip/gophercloud"
+	"github.com/samuelbernardolip/gophercloud/openstack/networking/v2/extensions/attributestags"
+	"github.com/samuelbernardolip/gophercloud/openstack/networking/v2/extensions/qos/policies"
+)
+
+func resourceNetworkingQoSPolicyV2() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetworkingQoSPolicyV2Create,
+		Read:   resourceNetworkingQoSPolicyV2Read,
+		Update: resourceNetworkingQoSPolicyV2Update,
+		Delete: resourceNetworkingQoSPolicyV2Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: false,
+			},
+			"project_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
+			"created_at": &schema.Schema{
+				Type:     schema.TypeString,
+				ForceNew: false,
+				Computed: true,
+			},
+			"updated_at": &schema.Schema{
+				Type:     schema.TypeString,
+				ForceNew: false,
+				Computed: true,
+			},
+			"shared": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: false,
+			},
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: false,
+			},
+			"is_default": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: false,
+			},
+			"revision_number": &schema.Schema{
+				Type:     schema.TypeInt,
+				ForceNew: false,
+				Computed: true,
+			},
+			"value_specs": &schema.Schema{
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+			},
+			"tags": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceNetworkingQoSPolicyV2Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	createOpts := QoSPolicyCreateOpts{
+		policies.CreateOpts{
+			Name:        d.Get("name").(string),
+			ProjectID:   d.Get("project_id").(string),
+			Shared:      d.Get("shared").(bool),
+			Description: d.Get("description").(string),
+			IsDefault:   d.Get("is_default").(bool),
+		},
+		MapValueSpecs(d),
+	}
+
+	log.Printf("[DEBUG] Create Options: %#v", createOpts)
+
+	p, err := policies.Create(networkingClient, createOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack Neutron QoS policy: %s", err)
+	}
+
+	d.SetId(p.ID)
+
+	tags := networkV2AttributesTags(d)
+	if len(tags) > 0 {
+		tagOpts := attributestags.ReplaceAllOpts{Tags: tags}
+		tags, err := attributestags.ReplaceAll(networkingClient, "qos/policies", p.ID, tagOpts).Extract()
+		if err != nil {
+			return fmt.Errorf("Error creating Tags on QoS policy: %s", err)
+		}
+		log.Printf("[DEBUG] Set Tags = %+v on QoS policy %+v", tags, p.ID)
+	}
+
+	log.Printf("[DEBUG] Created QoS policy %s: %#v", p.ID, p)
+	return resourceNetworkingQoSPolicyV2Read(d, meta)
+}
+
+func resourceNetworkingQoSPolicyV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	p, err := policies.Get(networkingClient, d.Id()).Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "QoS policy")
+	}
+
+	log.Printf("[DEBUG] Retrieved QoS policy %s: %#v", d.Id(), p)
+
+	d.Set("name", p.Name)
+	d.Set("project_id", p.ProjectID)
+	d.Set("shared", p.Shared)
+	d.Set("is_default", p.IsDefault)
+	d.Set("description", p.Description)
+	d.Set("revision_number", p.RevisionNumber)
+	d.Set("region", GetRegion(d, config))
+	d.Set("tags", p.Tags)
+
+	if !p.CreatedAt.IsZero() {
+		d.Set("created_at", p.CreatedAt.Format(time.RFC3339))
+	}
+	if !p.UpdatedAt.IsZero() {
+		d.Set("updated_at", p.UpdatedAt.Format(time.RFC3339))
+	}
+
+	return nil
+}
+
+func resourceNetworkingQoSPolicyV2Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	var hasChange bool
+	var updateOpts policies.UpdateOpts
+
+	if d.HasChange("name") {
+		hasChange = true
+		updateOpts.Name = d.Get("name").(string)
+	}
+
+	if d.HasChange("shared") {
+		hasChange = true
+		v := d.Get("shared").(bool)
+		updateOpts.Shared = &v
+	}
+
+	if d.HasChange("description") {
+		hasChange = true
+		v := d.Get("description").(string)
+		updateOpts.Description = &v
+	}
+
+	if d.HasChange("is_default") {
+		hasChange = true
+		v := d.Get("is_default").(bool)
+		updateOpts.IsDefault = &v
+	}
+
+	if hasChange {
+		log.Printf("[DEBUG] Updating QoS policy %s with options: %+v", d.Id(), updateOpts)
+
+		_, err = policies.Update(networkingClient, d.Id(), updateOpts).Extract()
+		if err != nil {
+			return fmt.Errorf("Error updating OpenStack Neutron QoS policy: %s", err)
+		}
+	}
+
+	if d.HasChange("tags") {
+		tags := networkV2AttributesTags(d)
+		tagOpts := attributestags.ReplaceAllOpts{Tags: tags}
+		tags, err := attributestags.ReplaceAll(networkingClient, "qos/policies", d.Id(), tagOpts).Extract()
+		if err != nil {
+			return fmt.Errorf("Error updating Tags on QoS policy: %s", err)
+		}
+		log.Printf("[DEBUG] Updated Tags = %+v on QoS policy %+v", tags, d.Id())
+	}
+
+	return resourceNetworkingQoSPolicyV2Read(d, meta)
+}
+
+func resourceNetworkingQoSPolicyV2Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"ACTIVE"},
+		Target:     []string{"DELETED"},
+		Refresh:    waitForQoSPolicyDelete(networkingClient, d.Id()),
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, err = stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf("Error deleting OpenStack Neutron QoS policy: %s", err)
+	}
+
+	return nil
+}
+
+// waitForQoSPolicyDelete retries the deletion while the QoS policy is still
+// attached to a port or a network, which Neutron reports with a 409.
+func waitForQoSPolicyDelete(networkingClient *gophercloud.ServiceClient, policyID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		log.Printf("[DEBUG] Attempting to delete OpenStack QoS policy %s.\n", policyID)
+
+		p, err := policies.Get(networkingClient, policyID).Extract()
+		if err != nil {
+			if _, ok := err.(gophercloud.ErrDefault404); ok {
+				log.Printf("[DEBUG] Successfully deleted OpenStack QoS policy %s", policyID)
+				return p, "DELETED", nil
+			}
+			return p, "ACTIVE", err
+		}
+
+		err = policies.Delete(networkingClient, policyID).ExtractErr()
+		if err != nil {
+			if _, ok := err.(gophercloud.ErrDefault404); ok {
+				log.Printf("[DEBUG] Successfully deleted OpenStack QoS policy %s", policyID)
+				return p, "DELETED", nil
+			}
+			if errCode, ok := err.(gophercloud.ErrUnexpectedResponseCode); ok {
+				if errCode.Actual == 409 {
+					return p, "ACTIVE", nil
+				}
+			}
+			return p, "ACTIVE", err
+		}
+
+		log.Printf("[DEBUG] OpenStack QoS policy %s still active.\n", policyID)
+		return p, "ACTIVE", nil
+	}
+}
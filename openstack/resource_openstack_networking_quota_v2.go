@@ -0,0 +1,195 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+
+	"github.com/samuelbernardolip/gophercloud/openstack/networking/v2/extensions/quotas"
+)
+
+func resourceNetworkingQuotaV2() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetworkingQuotaV2Create,
+		Read:   resourceNetworkingQuotaV2Read,
+		Update: resourceNetworkingQuotaV2Update,
+		Delete: resourceNetworkingQuotaV2Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"project_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"floatingip": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+
+			"network": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+
+			"port": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+
+			"rbac_policy": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+
+			"router": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+
+			"security_group": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+
+			"security_group_rule": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+
+			"subnet": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+
+			"subnetpool": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceNetworkingQuotaV2Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	projectID := d.Get("project_id").(string)
+	updateOpts := quotas.UpdateOpts{
+		FloatingIP:        quotaValue(d, "floatingip"),
+		Network:           quotaValue(d, "network"),
+		Port:              quotaValue(d, "port"),
+		RBACPolicy:        quotaValue(d, "rbac_policy"),
+		Router:            quotaValue(d, "router"),
+		SecurityGroup:     quotaValue(d, "security_group"),
+		SecurityGroupRule: quotaValue(d, "security_group_rule"),
+		Subnet:            quotaValue(d, "subnet"),
+		SubnetPool:        quotaValue(d, "subnetpool"),
+	}
+
+	log.Printf("[DEBUG] openstack_networking_quota_v2 create options: %#v", updateOpts)
+	_, err = quotas.Update(networkingClient, projectID, updateOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error creating openstack_networking_quota_v2 for project %s: %s", projectID, err)
+	}
+
+	d.SetId(projectID)
+
+	return resourceNetworkingQuotaV2Read(d, meta)
+}
+
+func resourceNetworkingQuotaV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	q, err := quotas.Get(networkingClient, d.Id()).Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "openstack_networking_quota_v2")
+	}
+
+	log.Printf("[DEBUG] Retrieved openstack_networking_quota_v2 %s: %#v", d.Id(), q)
+
+	d.Set("project_id", d.Id())
+	d.Set("region", GetRegion(d, config))
+	d.Set("floatingip", q.FloatingIP)
+	d.Set("network", q.Network)
+	d.Set("port", q.Port)
+	d.Set("rbac_policy", q.RBACPolicy)
+	d.Set("router", q.Router)
+	d.Set("security_group", q.SecurityGroup)
+	d.Set("security_group_rule", q.SecurityGroupRule)
+	d.Set("subnet", q.Subnet)
+	d.Set("subnetpool", q.SubnetPool)
+
+	return nil
+}
+
+func resourceNetworkingQuotaV2Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	updateOpts := quotas.UpdateOpts{
+		FloatingIP:        quotaChangedValue(d, "floatingip"),
+		Network:           quotaChangedValue(d, "network"),
+		Port:              quotaChangedValue(d, "port"),
+		RBACPolicy:        quotaChangedValue(d, "rbac_policy"),
+		Router:            quotaChangedValue(d, "router"),
+		SecurityGroup:     quotaChangedValue(d, "security_group"),
+		SecurityGroupRule: quotaChangedValue(d, "security_group_rule"),
+		Subnet:            quotaChangedValue(d, "subnet"),
+		SubnetPool:        quotaChangedValue(d, "subnetpool"),
+	}
+
+	log.Printf("[DEBUG] openstack_networking_quota_v2 %s update options: %#v", d.Id(), updateOpts)
+	_, err = quotas.Update(networkingClient, d.Id(), updateOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error updating openstack_networking_quota_v2 %s: %s", d.Id(), err)
+	}
+
+	return resourceNetworkingQuotaV2Read(d, meta)
+}
+
+func resourceNetworkingQuotaV2Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	log.Printf("[DEBUG] Restoring default quotas for openstack_networking_quota_v2 %s", d.Id())
+	err = quotas.Delete(networkingClient, d.Id()).ExtractErr()
+	if err != nil {
+		return CheckDeleted(d, err, "Error restoring default quotas for openstack_networking_quota_v2")
+	}
+
+	return nil
+}
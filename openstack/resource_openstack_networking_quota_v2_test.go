@@ -0,0 +1,85 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccNetworkingV2Quota_basic(t *testing.T) {
+	projectName := fmt.Sprintf("tf_test_%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIdentityV3ProjectDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccNetworkingV2Quota_basic(projectName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"openstack_networking_quota_v2.quota_1", "project_id",
+						"openstack_identity_project_v3.project_1", "id"),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_quota_v2.quota_1", "network", "4"),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_quota_v2.quota_1", "port", "20"),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_quota_v2.quota_1", "router", "2"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccNetworkingV2Quota_update(projectName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"openstack_networking_quota_v2.quota_1", "project_id",
+						"openstack_identity_project_v3.project_1", "id"),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_quota_v2.quota_1", "network", "8"),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_quota_v2.quota_1", "port", "40"),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_quota_v2.quota_1", "router", "4"),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_quota_v2.quota_1", "floatingip", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNetworkingV2Quota_basic(projectName string) string {
+	return fmt.Sprintf(`
+resource "openstack_identity_project_v3" "project_1" {
+  name = "%s"
+}
+
+resource "openstack_networking_quota_v2" "quota_1" {
+  project_id = "${openstack_identity_project_v3.project_1.id}"
+  network    = 4
+  port       = 20
+  router     = 2
+}
+`, projectName)
+}
+
+func testAccNetworkingV2Quota_update(projectName string) string {
+	return fmt.Sprintf(`
+resource "openstack_identity_project_v3" "project_1" {
+  name = "%s"
+}
+
+resource "openstack_networking_quota_v2" "quota_1" {
+  project_id = "${openstack_identity_project_v3.project_1.id}"
+  network    = 8
+  port       = 40
+  router     = 4
+  floatingip = 0
+}
+`, projectName)
+}
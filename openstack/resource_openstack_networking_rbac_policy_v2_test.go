@@ -0,0 +1,189 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+
+	"github.com/samuelbernardolip/gophercloud/openstack/networking/v2/extensions/rbacpolicies"
+)
+
+func TestAccNetworkingV2RBACPolicy_basic(t *testing.T) {
+	var rbac rbacpolicies.RBACPolicy
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNetworkingV2RBACPolicyDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccNetworkingV2RBACPolicy_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingV2RBACPolicyExists("openstack_networking_rbac_policy_v2.rbac_policy_1", &rbac),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_rbac_policy_v2.rbac_policy_1", "action", "access_as_shared"),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_rbac_policy_v2.rbac_policy_1", "object_type", "network"),
+					resource.TestCheckResourceAttrPair(
+						"openstack_networking_rbac_policy_v2.rbac_policy_1", "object_id",
+						"openstack_networking_network_v2.network_1", "id"),
+					resource.TestCheckResourceAttrPair(
+						"openstack_networking_rbac_policy_v2.rbac_policy_1", "target_tenant",
+						"openstack_identity_project_v3.project_1", "id"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccNetworkingV2RBACPolicy_update,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingV2RBACPolicyExists("openstack_networking_rbac_policy_v2.rbac_policy_1", &rbac),
+					resource.TestCheckResourceAttrPair(
+						"openstack_networking_rbac_policy_v2.rbac_policy_1", "target_tenant",
+						"openstack_identity_project_v3.project_2", "id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccNetworkingV2RBACPolicy_external(t *testing.T) {
+	var rbac rbacpolicies.RBACPolicy
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNetworkingV2RBACPolicyDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccNetworkingV2RBACPolicy_external,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingV2RBACPolicyExists("openstack_networking_rbac_policy_v2.rbac_policy_1", &rbac),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_rbac_policy_v2.rbac_policy_1", "action", "access_as_external"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckNetworkingV2RBACPolicyExists(n string, rbac *rbacpolicies.RBACPolicy) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		networkingClient, err := config.networkingV2Client(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+		}
+
+		found, err := rbacpolicies.Get(networkingClient, rs.Primary.ID).Extract()
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return fmt.Errorf("RBAC policy not found")
+		}
+
+		*rbac = *found
+
+		return nil
+	}
+}
+
+func testAccCheckNetworkingV2RBACPolicyDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	networkingClient, err := config.networkingV2Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "openstack_networking_rbac_policy_v2" {
+			continue
+		}
+
+		_, err := rbacpolicies.Get(networkingClient, rs.Primary.ID).Extract()
+		if err == nil {
+			return fmt.Errorf("RBAC policy still exists")
+		}
+	}
+
+	return nil
+}
+
+const testAccNetworkingV2RBACPolicy_basic = `
+resource "openstack_identity_project_v3" "project_1" {
+  name = "project_1"
+}
+
+resource "openstack_identity_project_v3" "project_2" {
+  name = "project_2"
+}
+
+resource "openstack_networking_network_v2" "network_1" {
+  name = "network_1"
+  admin_state_up = "true"
+}
+
+resource "openstack_networking_rbac_policy_v2" "rbac_policy_1" {
+  action = "access_as_shared"
+  object_id = "${openstack_networking_network_v2.network_1.id}"
+  object_type = "network"
+  target_tenant = "${openstack_identity_project_v3.project_1.id}"
+}
+`
+
+const testAccNetworkingV2RBACPolicy_update = `
+resource "openstack_identity_project_v3" "project_1" {
+  name = "project_1"
+}
+
+resource "openstack_identity_project_v3" "project_2" {
+  name = "project_2"
+}
+
+resource "openstack_networking_network_v2" "network_1" {
+  name = "network_1"
+  admin_state_up = "true"
+}
+
+resource "openstack_networking_rbac_policy_v2" "rbac_policy_1" {
+  action = "access_as_shared"
+  object_id = "${openstack_networking_network_v2.network_1.id}"
+  object_type = "network"
+  target_tenant = "${openstack_identity_project_v3.project_2.id}"
+}
+`
+
+const testAccNetworkingV2RBACPolicy_external = `
+resource "openstack_identity_project_v3" "project_1" {
+  name = "project_1"
+}
+
+resource "openstack_networking_network_v2" "network_1" {
+  name = "network_1"
+  admin_state_up = "true"
+}
+
+resource "openstack_networking_rbac_policy_v2" "rbac_policy_1" {
+  action = "access_as_external"
+  object_id = "${openstack_networking_network_v2.network_1.id}"
+  object_type = "network"
+  target_tenant = "${openstack_identity_project_v3.project_1.id}"
+}
+`
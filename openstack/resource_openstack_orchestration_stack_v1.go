@@ -0,0 +1,334 @@
+package openstack
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/samuelbernardolip/gophercloud/openstack/orchestration/v1/stacks"
+)
+
+func resourceOrchestrationStackV1() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceOrchestrationStackV1Create,
+		Read:   resourceOrchestrationStackV1Read,
+		Update: resourceOrchestrationStackV1Update,
+		Delete: resourceOrchestrationStackV1Delete,
+		Importer: &schema.ResourceImporter{
+			State: resourceOrchestrationStackV1ImportState,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"template": &schema.Schema{
+				Type:             schema.TypeString,
+				Required:         true,
+				DiffSuppressFunc: suppressOrchestrationStackV1TemplateDiffs,
+			},
+
+			"environment": &schema.Schema{
+				Type:             schema.TypeString,
+				Optional:         true,
+				DiffSuppressFunc: suppressOrchestrationStackV1TemplateDiffs,
+			},
+
+			"files": &schema.Schema{
+				Type:     schema.TypeMap,
+				Optional: true,
+			},
+
+			"parameters": &schema.Schema{
+				Type:     schema.TypeMap,
+				Optional: true,
+			},
+
+			"timeout": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+
+			"disable_rollback": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+
+			"tags": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"outputs": &schema.Schema{
+				Type:     schema.TypeMap,
+				Computed: true,
+			},
+
+			"status": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"status_reason": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"parent_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"creation_time": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"updated_time": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceOrchestrationStackV1Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	orchestrationClient, err := config.orchestrationV1Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack orchestration client: %s", err)
+	}
+
+	createOpts := stacks.CreateOpts{
+		Name:        d.Get("name").(string),
+		Template:    d.Get("template").(string),
+		Environment: d.Get("environment").(string),
+		Files:       orchestrationStackV1Files(d),
+		Parameters:  d.Get("parameters").(map[string]interface{}),
+		Timeout:     d.Get("timeout").(int),
+		Tags:        orchestrationStackV1Tags(d),
+	}
+
+	if v, ok := d.GetOkExists("disable_rollback"); ok {
+		disableRollback := v.(bool)
+		createOpts.DisableRollback = &disableRollback
+	}
+
+	log.Printf("[DEBUG] openstack_orchestration_stack_v1 create options: %#v", createOpts)
+
+	stack, err := stacks.Create(orchestrationClient, createOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error creating openstack_orchestration_stack_v1: %s", err)
+	}
+
+	d.SetId(stack.ID)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"INIT_COMPLETE", "CREATE_IN_PROGRESS", "ROLLBACK_IN_PROGRESS"},
+		Target:     []string{"CREATE_COMPLETE"},
+		Refresh:    orchestrationStackV1StateRefreshFunc(orchestrationClient, stack.ID, "CREATE", time.Time{}),
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		Delay:      5 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+
+	_, err = stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf("Error waiting for openstack_orchestration_stack_v1 %s to become ready: %s", stack.ID, err)
+	}
+
+	log.Printf("[DEBUG] Created openstack_orchestration_stack_v1 %s", stack.ID)
+
+	return resourceOrchestrationStackV1Read(d, meta)
+}
+
+func resourceOrchestrationStackV1Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	orchestrationClient, err := config.orchestrationV1Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack orchestration client: %s", err)
+	}
+
+	stack, err := stacks.Find(orchestrationClient, d.Id()).Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "Error retrieving openstack_orchestration_stack_v1")
+	}
+
+	log.Printf("[DEBUG] Retrieved openstack_orchestration_stack_v1 %s: %#v", d.Id(), stack)
+
+	if stack.Status == "DELETE_COMPLETE" {
+		log.Printf("[DEBUG] openstack_orchestration_stack_v1 %s has been deleted", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("name", stack.Name)
+	d.Set("description", stack.Description)
+	d.Set("timeout", stack.Timeout)
+	d.Set("disable_rollback", stack.DisableRollback)
+	d.Set("tags", stack.Tags)
+	d.Set("parameters", orchestrationStackV1ConfiguredParameters(d, stack.Parameters))
+	d.Set("outputs", flattenOrchestrationStackV1Outputs(stack.Outputs))
+	d.Set("status", stack.Status)
+	d.Set("status_reason", stack.StatusReason)
+	d.Set("parent_id", stack.ParentID)
+	d.Set("creation_time", stack.CreationTime.Format(time.RFC3339))
+
+	if !stack.UpdatedTime.IsZero() {
+		d.Set("updated_time", stack.UpdatedTime.Format(time.RFC3339))
+	}
+
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceOrchestrationStackV1Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	orchestrationClient, err := config.orchestrationV1Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack orchestration client: %s", err)
+	}
+
+	// The whole stack definition is sent on every update,
+	// parameters which are not set revert to their default values.
+	updateOpts := stacks.UpdateOpts{
+		Template:    d.Get("template").(string),
+		Environment: d.Get("environment").(string),
+		Files:       orchestrationStackV1Files(d),
+		Parameters:  d.Get("parameters").(map[string]interface{}),
+		Timeout:     d.Get("timeout").(int),
+		Tags:        orchestrationStackV1Tags(d),
+	}
+
+	if v, ok := d.GetOkExists("disable_rollback"); ok {
+		disableRollback := v.(bool)
+		updateOpts.DisableRollback = &disableRollback
+	}
+
+	// Heat only sets updated_time once a stack has been updated.
+	stack, err := stacks.Find(orchestrationClient, d.Id()).Extract()
+	if err != nil {
+		return fmt.Errorf("Error retrieving openstack_orchestration_stack_v1 %s: %s", d.Id(), err)
+	}
+
+	lastUpdated := stack.UpdatedTime
+	if lastUpdated.IsZero() {
+		lastUpdated = stack.CreationTime
+	}
+
+	log.Printf("[DEBUG] openstack_orchestration_stack_v1 %s update options: %#v", d.Id(), updateOpts)
+
+	err = stacks.Update(orchestrationClient, d.Get("name").(string), d.Id(), updateOpts).ExtractErr()
+	if err != nil {
+		return fmt.Errorf("Error updating openstack_orchestration_stack_v1 %s: %s", d.Id(), err)
+	}
+
+	// The stack may still report its previous status right after the
+	// update request has been accepted, until its updated_time changes.
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"UPDATE_PENDING", "UPDATE_IN_PROGRESS", "ROLLBACK_IN_PROGRESS"},
+		Target:     []string{"UPDATE_COMPLETE"},
+		Refresh:    orchestrationStackV1StateRefreshFunc(orchestrationClient, d.Id(), "UPDATE", lastUpdated),
+		Timeout:    d.Timeout(schema.TimeoutUpdate),
+		Delay:      10 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+
+	_, err = stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf("Error waiting for openstack_orchestration_stack_v1 %s to update: %s", d.Id(), err)
+	}
+
+	return resourceOrchestrationStackV1Read(d, meta)
+}
+
+func resourceOrchestrationStackV1Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	orchestrationClient, err := config.orchestrationV1Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack orchestration client: %s", err)
+	}
+
+	err = stacks.Delete(orchestrationClient, d.Get("name").(string), d.Id()).ExtractErr()
+	if err != nil {
+		return CheckDeleted(d, err, "Error deleting openstack_orchestration_stack_v1")
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			"DELETE_IN_PROGRESS", "CREATE_COMPLETE", "CREATE_FAILED",
+			"UPDATE_COMPLETE", "UPDATE_FAILED", "ROLLBACK_COMPLETE",
+		},
+		Target:     []string{"DELETE_COMPLETE"},
+		Refresh:    orchestrationStackV1StateRefreshFunc(orchestrationClient, d.Id(), "DELETE", time.Time{}),
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		Delay:      5 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+
+	_, err = stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf("Error waiting for openstack_orchestration_stack_v1 %s to delete: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceOrchestrationStackV1ImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	config := meta.(*Config)
+	orchestrationClient, err := config.orchestrationV1Client(GetRegion(d, config))
+	if err != nil {
+		return nil, fmt.Errorf("Error creating OpenStack orchestration client: %s", err)
+	}
+
+	// The stack can be imported by either its name or its ID.
+	stack, err := stacks.Find(orchestrationClient, d.Id()).Extract()
+	if err != nil {
+		return nil, fmt.Errorf("Error retrieving openstack_orchestration_stack_v1 %s: %s", d.Id(), err)
+	}
+
+	template, err := stacks.GetTemplate(orchestrationClient, stack.Name, stack.ID).Extract()
+	if err != nil {
+		return nil, fmt.Errorf("Error retrieving template of openstack_orchestration_stack_v1 %s: %s", stack.ID, err)
+	}
+
+	templateJSON, err := json.Marshal(template)
+	if err != nil {
+		return nil, fmt.Errorf("Error marshalling template of openstack_orchestration_stack_v1 %s: %s", stack.ID, err)
+	}
+
+	d.SetId(stack.ID)
+	d.Set("template", string(templateJSON))
+	d.Set("parameters", orchestrationStackV1Parameters(stack.Parameters))
+
+	return []*schema.ResourceData{d}, nil
+}
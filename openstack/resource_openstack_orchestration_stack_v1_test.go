@@ -0,0 +1,175 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+
+	"github.com/samuelbernardolip/gophercloud/openstack/orchestration/v1/stacks"
+)
+
+func TestAccOrchestrationV1Stack_basic(t *testing.T) {
+	var stack stacks.RetrievedStack
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckOrchestration(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckOrchestrationV1StackDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccOrchestrationV1Stack_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOrchestrationV1StackExists("openstack_orchestration_stack_v1.stack_1", &stack),
+					resource.TestCheckResourceAttr("openstack_orchestration_stack_v1.stack_1", "name", "stack_1"),
+					resource.TestCheckResourceAttr("openstack_orchestration_stack_v1.stack_1", "status", "CREATE_COMPLETE"),
+					resource.TestCheckResourceAttr("openstack_orchestration_stack_v1.stack_1", "parameters.length", "8"),
+					resource.TestCheckResourceAttr("openstack_orchestration_stack_v1.stack_1", "outputs.length", "8"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccOrchestrationV1Stack_update,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOrchestrationV1StackExists("openstack_orchestration_stack_v1.stack_1", &stack),
+					resource.TestCheckResourceAttr("openstack_orchestration_stack_v1.stack_1", "status", "UPDATE_COMPLETE"),
+					resource.TestCheckResourceAttr("openstack_orchestration_stack_v1.stack_1", "parameters.length", "16"),
+					resource.TestCheckResourceAttr("openstack_orchestration_stack_v1.stack_1", "outputs.length", "16"),
+					resource.TestCheckResourceAttr("openstack_orchestration_stack_v1.stack_1", "tags.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccOrchestrationV1Stack_timeout(t *testing.T) {
+	var stack stacks.RetrievedStack
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckOrchestration(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckOrchestrationV1StackDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccOrchestrationV1Stack_timeout,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOrchestrationV1StackExists("openstack_orchestration_stack_v1.stack_1", &stack),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckOrchestrationV1StackDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	orchestrationClient, err := config.orchestrationV1Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack orchestration client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "openstack_orchestration_stack_v1" {
+			continue
+		}
+
+		stack, err := stacks.Find(orchestrationClient, rs.Primary.ID).Extract()
+		if err == nil && stack.Status != "DELETE_COMPLETE" {
+			return fmt.Errorf("Stack still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckOrchestrationV1StackExists(n string, stack *stacks.RetrievedStack) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		orchestrationClient, err := config.orchestrationV1Client(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack orchestration client: %s", err)
+		}
+
+		found, err := stacks.Find(orchestrationClient, rs.Primary.ID).Extract()
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return fmt.Errorf("Stack not found")
+		}
+
+		*stack = *found
+
+		return nil
+	}
+}
+
+const testAccOrchestrationV1Stack_template = `
+heat_template_version: 2015-04-30
+parameters:
+  length:
+    type: number
+    default: 4
+resources:
+  random:
+    type: OS::Heat::RandomString
+    properties:
+      length: { get_param: length }
+outputs:
+  length:
+    value: { get_param: length }
+  value:
+    value: { get_attr: [random, value] }
+`
+
+var testAccOrchestrationV1Stack_basic = fmt.Sprintf(`
+resource "openstack_orchestration_stack_v1" "stack_1" {
+  name = "stack_1"
+  template = <<EOT
+%s
+EOT
+
+  parameters {
+    length = 8
+  }
+}
+`, testAccOrchestrationV1Stack_template)
+
+var testAccOrchestrationV1Stack_update = fmt.Sprintf(`
+resource "openstack_orchestration_stack_v1" "stack_1" {
+  name = "stack_1"
+  template = <<EOT
+%s
+EOT
+
+  parameters {
+    length = 16
+  }
+
+  tags = ["foo"]
+}
+`, testAccOrchestrationV1Stack_template)
+
+var testAccOrchestrationV1Stack_timeout = fmt.Sprintf(`
+resource "openstack_orchestration_stack_v1" "stack_1" {
+  name = "stack_1"
+  template = <<EOT
+%s
+EOT
+
+  timeouts {
+    create = "5m"
+    update = "5m"
+    delete = "5m"
+  }
+}
+`, testAccOrchestrationV1Stack_template)
@@ -0,0 +1,223 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/samuelbernardolip/gophercloud/openstack/sharedfilesystems/v2/securityservices"
+)
+
+func resourceSharedFilesystemSecurityServiceV2() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceSharedFilesystemSecurityServiceV2Create,
+		Read:   resourceSharedFilesystemSecurityServiceV2Read,
+		Update: resourceSharedFilesystemSecurityServiceV2Update,
+		Delete: resourceSharedFilesystemSecurityServiceV2Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"type": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"active_directory", "kerberos", "ldap",
+				}, false),
+			},
+
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"dns_ip": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"user": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"password": &schema.Schema{
+				Type:      schema.TypeString,
+				Optional:  true,
+				Sensitive: true,
+			},
+
+			"domain": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"server": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"project_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceSharedFilesystemSecurityServiceV2Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	sfsClient, err := config.sharedfilesystemV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack sharedfilesystem client: %s", err)
+	}
+
+	sfsClient.Microversion = sharedFilesystemV2MinMicroversion
+
+	createOpts := securityservices.CreateOpts{
+		Type:        securityservices.SecurityServiceType(d.Get("type").(string)),
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+		DNSIP:       d.Get("dns_ip").(string),
+		User:        d.Get("user").(string),
+		Domain:      d.Get("domain").(string),
+		Server:      d.Get("server").(string),
+	}
+
+	log.Printf("[DEBUG] openstack_sharedfilesystem_securityservice_v2 create options: %#v", createOpts)
+
+	// Don't log the password.
+	createOpts.Password = d.Get("password").(string)
+
+	securityService, err := securityservices.Create(sfsClient, createOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error creating openstack_sharedfilesystem_securityservice_v2: %s", err)
+	}
+
+	d.SetId(securityService.ID)
+
+	log.Printf("[DEBUG] Created openstack_sharedfilesystem_securityservice_v2 %s", securityService.ID)
+
+	return resourceSharedFilesystemSecurityServiceV2Read(d, meta)
+}
+
+func resourceSharedFilesystemSecurityServiceV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	sfsClient, err := config.sharedfilesystemV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack sharedfilesystem client: %s", err)
+	}
+
+	sfsClient.Microversion = sharedFilesystemV2MinMicroversion
+
+	securityService, err := securityservices.Get(sfsClient, d.Id()).Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "Error retrieving openstack_sharedfilesystem_securityservice_v2")
+	}
+
+	// The password isn't returned by the API.
+	securityService.Password = ""
+	log.Printf("[DEBUG] Retrieved openstack_sharedfilesystem_securityservice_v2 %s: %#v", d.Id(), securityService)
+
+	d.Set("type", securityService.Type)
+	d.Set("name", securityService.Name)
+	d.Set("description", securityService.Description)
+	d.Set("dns_ip", securityService.DNSIP)
+	d.Set("user", securityService.User)
+	d.Set("domain", securityService.Domain)
+	d.Set("server", securityService.Server)
+	d.Set("project_id", securityService.ProjectID)
+
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceSharedFilesystemSecurityServiceV2Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	sfsClient, err := config.sharedfilesystemV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack sharedfilesystem client: %s", err)
+	}
+
+	sfsClient.Microversion = sharedFilesystemV2MinMicroversion
+
+	var updateOpts securityservices.UpdateOpts
+
+	if d.HasChange("type") {
+		updateOpts.Type = d.Get("type").(string)
+	}
+
+	if d.HasChange("name") {
+		name := d.Get("name").(string)
+		updateOpts.Name = &name
+	}
+
+	if d.HasChange("description") {
+		description := d.Get("description").(string)
+		updateOpts.Description = &description
+	}
+
+	if d.HasChange("dns_ip") {
+		dnsIP := d.Get("dns_ip").(string)
+		updateOpts.DNSIP = &dnsIP
+	}
+
+	if d.HasChange("user") {
+		user := d.Get("user").(string)
+		updateOpts.User = &user
+	}
+
+	if d.HasChange("domain") {
+		domain := d.Get("domain").(string)
+		updateOpts.Domain = &domain
+	}
+
+	if d.HasChange("server") {
+		server := d.Get("server").(string)
+		updateOpts.Server = &server
+	}
+
+	log.Printf("[DEBUG] openstack_sharedfilesystem_securityservice_v2 %s update options: %#v", d.Id(), updateOpts)
+
+	if d.HasChange("password") {
+		password := d.Get("password").(string)
+		updateOpts.Password = &password
+	}
+
+	_, err = securityservices.Update(sfsClient, d.Id(), updateOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error updating openstack_sharedfilesystem_securityservice_v2 %s: %s", d.Id(), err)
+	}
+
+	return resourceSharedFilesystemSecurityServiceV2Read(d, meta)
+}
+
+func resourceSharedFilesystemSecurityServiceV2Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	sfsClient, err := config.sharedfilesystemV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack sharedfilesystem client: %s", err)
+	}
+
+	err = securityservices.Delete(sfsClient, d.Id()).ExtractErr()
+	if err != nil {
+		return CheckDeleted(d, err, "Error deleting openstack_sharedfilesystem_securityservice_v2")
+	}
+
+	return nil
+}
@@ -0,0 +1,120 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+
+	"github.com/samuelbernardolip/gophercloud/openstack/sharedfilesystems/v2/securityservices"
+)
+
+func TestAccSFSV2SecurityService_basic(t *testing.T) {
+	var securityService securityservices.SecurityService
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckSFS(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSFSV2SecurityServiceDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccSFSV2SecurityService_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSFSV2SecurityServiceExists("openstack_sharedfilesystem_securityservice_v2.securityservice_1", &securityService),
+					resource.TestCheckResourceAttr("openstack_sharedfilesystem_securityservice_v2.securityservice_1", "name", "security"),
+					resource.TestCheckResourceAttr("openstack_sharedfilesystem_securityservice_v2.securityservice_1", "type", "active_directory"),
+					resource.TestCheckResourceAttr("openstack_sharedfilesystem_securityservice_v2.securityservice_1", "dns_ip", "192.168.199.10"),
+					resource.TestCheckResourceAttr("openstack_sharedfilesystem_securityservice_v2.securityservice_1", "domain", "example.com"),
+					resource.TestCheckResourceAttr("openstack_sharedfilesystem_securityservice_v2.securityservice_1", "user", "joinDomainUser"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccSFSV2SecurityService_update,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSFSV2SecurityServiceExists("openstack_sharedfilesystem_securityservice_v2.securityservice_1", &securityService),
+					resource.TestCheckResourceAttr("openstack_sharedfilesystem_securityservice_v2.securityservice_1", "name", "security_through_obscurity"),
+					resource.TestCheckResourceAttr("openstack_sharedfilesystem_securityservice_v2.securityservice_1", "type", "kerberos"),
+					resource.TestCheckResourceAttr("openstack_sharedfilesystem_securityservice_v2.securityservice_1", "server", "192.168.199.11"),
+					resource.TestCheckResourceAttr("openstack_sharedfilesystem_securityservice_v2.securityservice_1", "user", ""),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckSFSV2SecurityServiceDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	sfsClient, err := config.sharedfilesystemV2Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack sharedfilesystem client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "openstack_sharedfilesystem_securityservice_v2" {
+			continue
+		}
+
+		_, err := securityservices.Get(sfsClient, rs.Primary.ID).Extract()
+		if err == nil {
+			return fmt.Errorf("Security service still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckSFSV2SecurityServiceExists(n string, securityService *securityservices.SecurityService) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		sfsClient, err := config.sharedfilesystemV2Client(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack sharedfilesystem client: %s", err)
+		}
+
+		found, err := securityservices.Get(sfsClient, rs.Primary.ID).Extract()
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return fmt.Errorf("Security service not found")
+		}
+
+		*securityService = *found
+
+		return nil
+	}
+}
+
+const testAccSFSV2SecurityService_basic = `
+resource "openstack_sharedfilesystem_securityservice_v2" "securityservice_1" {
+  name = "security"
+  description = "created by terraform"
+  type = "active_directory"
+  server = "192.168.199.10"
+  dns_ip = "192.168.199.10"
+  domain = "example.com"
+  user = "joinDomainUser"
+  password = "s8cret"
+}
+`
+
+const testAccSFSV2SecurityService_update = `
+resource "openstack_sharedfilesystem_securityservice_v2" "securityservice_1" {
+  name = "security_through_obscurity"
+  description = ""
+  type = "kerberos"
+  server = "192.168.199.11"
+  dns_ip = "192.168.199.11"
+}
+`
@@ -0,0 +1,215 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/samuelbernardolip/gophercloud/openstack/sharedfilesystems/v2/shares"
+)
+
+func resourceSharedFilesystemShareAccessV2() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceSharedFilesystemShareAccessV2Create,
+		Read:   resourceSharedFilesystemShareAccessV2Read,
+		Delete: resourceSharedFilesystemShareAccessV2Delete,
+		Importer: &schema.ResourceImporter{
+			State: resourceSharedFilesystemShareAccessV2Import,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"share_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"access_type": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"ip", "user", "cert", "cephx",
+				}, false),
+			},
+
+			"access_to": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"access_level": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"rw", "ro",
+				}, false),
+			},
+
+			"access_key": &schema.Schema{
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"state": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceSharedFilesystemShareAccessV2Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	sfsClient, err := config.sharedfilesystemV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack sharedfilesystem client: %s", err)
+	}
+
+	sfsClient.Microversion = sharedFilesystemV2AccessKeyMicroversion
+
+	shareID := d.Get("share_id").(string)
+	grantOpts := shares.GrantAccessOpts{
+		AccessType:  d.Get("access_type").(string),
+		AccessTo:    d.Get("access_to").(string),
+		AccessLevel: d.Get("access_level").(string),
+	}
+
+	log.Printf("[DEBUG] openstack_sharedfilesystem_share_access_v2 create options: %#v", grantOpts)
+
+	// Access rules can't be changed while the share is being modified,
+	// e.g. when another access rule is being applied.
+	var accessRight *shares.AccessRight
+	err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		accessRight, err = shares.GrantAccess(sfsClient, shareID, grantOpts).Extract()
+		if err != nil {
+			return checkForRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("Error creating openstack_sharedfilesystem_share_access_v2: %s", err)
+	}
+
+	d.SetId(accessRight.ID)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"new", "queued_to_apply", "applying"},
+		Target:     []string{"active"},
+		Refresh:    sharedFilesystemShareAccessV2StateRefreshFunc(sfsClient, shareID, accessRight.ID),
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		Delay:      1 * time.Second,
+		MinTimeout: 1 * time.Second,
+	}
+
+	_, err = stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf("Error waiting for openstack_sharedfilesystem_share_access_v2 %s to become active: %s", accessRight.ID, err)
+	}
+
+	log.Printf("[DEBUG] Created openstack_sharedfilesystem_share_access_v2 %s", accessRight.ID)
+
+	return resourceSharedFilesystemShareAccessV2Read(d, meta)
+}
+
+func resourceSharedFilesystemShareAccessV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	sfsClient, err := config.sharedfilesystemV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack sharedfilesystem client: %s", err)
+	}
+
+	sfsClient.Microversion = sharedFilesystemV2AccessKeyMicroversion
+
+	accessRight, err := sharedFilesystemShareAccessV2Get(sfsClient, d.Get("share_id").(string), d.Id())
+	if err != nil {
+		return CheckDeleted(d, err, "Error retrieving openstack_sharedfilesystem_share_access_v2")
+	}
+
+	log.Printf("[DEBUG] Retrieved openstack_sharedfilesystem_share_access_v2 %s: %#v", d.Id(), accessRight)
+
+	d.Set("access_type", accessRight.AccessType)
+	d.Set("access_to", accessRight.AccessTo)
+	d.Set("access_level", accessRight.AccessLevel)
+	d.Set("access_key", accessRight.AccessKey)
+	d.Set("state", accessRight.State)
+
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceSharedFilesystemShareAccessV2Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	sfsClient, err := config.sharedfilesystemV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack sharedfilesystem client: %s", err)
+	}
+
+	sfsClient.Microversion = sharedFilesystemV2AccessKeyMicroversion
+
+	shareID := d.Get("share_id").(string)
+	revokeOpts := shares.RevokeAccessOpts{
+		AccessID: d.Id(),
+	}
+
+	log.Printf("[DEBUG] openstack_sharedfilesystem_share_access_v2 %s delete options: %#v", d.Id(), revokeOpts)
+
+	err = resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+		err := shares.RevokeAccess(sfsClient, shareID, revokeOpts).ExtractErr()
+		if err != nil {
+			return checkForRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return CheckDeleted(d, err, "Error deleting openstack_sharedfilesystem_share_access_v2")
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"active", "new", "queued_to_deny", "denying"},
+		Target:     []string{"deleted"},
+		Refresh:    sharedFilesystemShareAccessV2StateRefreshFunc(sfsClient, shareID, d.Id()),
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		Delay:      1 * time.Second,
+		MinTimeout: 1 * time.Second,
+	}
+
+	_, err = stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf("Error waiting for openstack_sharedfilesystem_share_access_v2 %s to delete: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceSharedFilesystemShareAccessV2Import(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.SplitN(d.Id(), "/", 2)
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("Invalid format specified for openstack_sharedfilesystem_share_access_v2. Format must be <share id>/<ACL id>")
+	}
+
+	d.SetId(parts[1])
+	d.Set("share_id", parts[0])
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,101 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+
+	"github.com/samuelbernardolip/gophercloud/openstack/sharedfilesystems/v2/shares"
+)
+
+func TestAccSFSV2ShareAccess_basic(t *testing.T) {
+	var accessRight shares.AccessRight
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckSFS(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSFSV2ShareAccessDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccSFSV2ShareAccess_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSFSV2ShareAccessExists("openstack_sharedfilesystem_share_access_v2.share_access_1", &accessRight),
+					resource.TestCheckResourceAttr("openstack_sharedfilesystem_share_access_v2.share_access_1", "access_type", "ip"),
+					resource.TestCheckResourceAttr("openstack_sharedfilesystem_share_access_v2.share_access_1", "access_to", "192.168.199.10"),
+					resource.TestCheckResourceAttr("openstack_sharedfilesystem_share_access_v2.share_access_1", "access_level", "rw"),
+					resource.TestCheckResourceAttr("openstack_sharedfilesystem_share_access_v2.share_access_1", "state", "active"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckSFSV2ShareAccessDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	sfsClient, err := config.sharedfilesystemV2Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack sharedfilesystem client: %s", err)
+	}
+
+	sfsClient.Microversion = sharedFilesystemV2MinMicroversion
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "openstack_sharedfilesystem_share_access_v2" {
+			continue
+		}
+
+		_, err := sharedFilesystemShareAccessV2Get(sfsClient, rs.Primary.Attributes["share_id"], rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("Share access still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckSFSV2ShareAccessExists(n string, accessRight *shares.AccessRight) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		sfsClient, err := config.sharedfilesystemV2Client(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack sharedfilesystem client: %s", err)
+		}
+
+		sfsClient.Microversion = sharedFilesystemV2MinMicroversion
+
+		found, err := sharedFilesystemShareAccessV2Get(sfsClient, rs.Primary.Attributes["share_id"], rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		*accessRight = *found
+
+		return nil
+	}
+}
+
+const testAccSFSV2ShareAccess_basic = `
+resource "openstack_sharedfilesystem_share_v2" "share_1" {
+  name = "nfs_share"
+  share_proto = "NFS"
+  size = 1
+}
+
+resource "openstack_sharedfilesystem_share_access_v2" "share_access_1" {
+  share_id = "${openstack_sharedfilesystem_share_v2.share_1.id}"
+  access_type = "ip"
+  access_to = "192.168.199.10"
+  access_level = "rw"
+}
+`
@@ -0,0 +1,377 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/samuelbernardolip/gophercloud/openstack/sharedfilesystems/v2/shares"
+)
+
+func resourceSharedFilesystemShareV2() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceSharedFilesystemShareV2Create,
+		Read:   resourceSharedFilesystemShareV2Read,
+		Update: resourceSharedFilesystemShareV2Update,
+		Delete: resourceSharedFilesystemShareV2Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"share_proto": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"NFS", "CIFS", "CEPHFS", "GLUSTERFS", "HDFS", "MAPRFS",
+				}, false),
+			},
+
+			"size": &schema.Schema{
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+
+			"share_type": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"snapshot_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"is_public": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+
+			"metadata": &schema.Schema{
+				Type:     schema.TypeMap,
+				Optional: true,
+			},
+
+			"share_network_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"availability_zone": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"export_locations": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"path": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"preferred": &schema.Schema{
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"project_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"host": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"share_server_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"has_replicas": &schema.Schema{
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
+			"replication_type": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"status": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceSharedFilesystemShareV2Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	sfsClient, err := config.sharedfilesystemV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack sharedfilesystem client: %s", err)
+	}
+
+	sfsClient.Microversion = sharedFilesystemV2MinMicroversion
+
+	createOpts := shares.CreateOpts{
+		Name:             d.Get("name").(string),
+		Description:      d.Get("description").(string),
+		ShareProto:       d.Get("share_proto").(string),
+		Size:             d.Get("size").(int),
+		ShareType:        d.Get("share_type").(string),
+		SnapshotID:       d.Get("snapshot_id").(string),
+		Metadata:         expandSharedFilesystemShareV2Metadata(d.Get("metadata").(map[string]interface{})),
+		ShareNetworkID:   d.Get("share_network_id").(string),
+		AvailabilityZone: d.Get("availability_zone").(string),
+	}
+
+	if v, ok := d.GetOkExists("is_public"); ok {
+		isPublic := v.(bool)
+		createOpts.IsPublic = &isPublic
+	}
+
+	log.Printf("[DEBUG] openstack_sharedfilesystem_share_v2 create options: %#v", createOpts)
+
+	share, err := shares.Create(sfsClient, createOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error creating openstack_sharedfilesystem_share_v2: %s", err)
+	}
+
+	d.SetId(share.ID)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"creating"},
+		Target:     []string{"available"},
+		Refresh:    sharedFilesystemShareV2StateRefreshFunc(sfsClient, share.ID),
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, err = stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf("Error waiting for openstack_sharedfilesystem_share_v2 %s to become available: %s", share.ID, err)
+	}
+
+	log.Printf("[DEBUG] Created openstack_sharedfilesystem_share_v2 %s", share.ID)
+
+	return resourceSharedFilesystemShareV2Read(d, meta)
+}
+
+func resourceSharedFilesystemShareV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	sfsClient, err := config.sharedfilesystemV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack sharedfilesystem client: %s", err)
+	}
+
+	sfsClient.Microversion = sharedFilesystemV2ExportLocationsMicroversion
+
+	share, err := shares.Get(sfsClient, d.Id()).Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "Error retrieving openstack_sharedfilesystem_share_v2")
+	}
+
+	log.Printf("[DEBUG] Retrieved openstack_sharedfilesystem_share_v2 %s: %#v", d.Id(), share)
+
+	exportLocations, err := shares.GetExportLocations(sfsClient, d.Id()).Extract()
+	if err != nil {
+		return fmt.Errorf("Error retrieving export locations of openstack_sharedfilesystem_share_v2 %s: %s", d.Id(), err)
+	}
+
+	d.Set("name", share.Name)
+	d.Set("description", share.Description)
+	d.Set("share_proto", share.ShareProto)
+	d.Set("size", share.Size)
+
+	// The share type can be specified by either its name or its ID.
+	if d.Get("share_type").(string) != share.ShareType {
+		d.Set("share_type", share.ShareTypeName)
+	}
+
+	d.Set("snapshot_id", share.SnapshotID)
+	d.Set("is_public", share.IsPublic)
+	d.Set("metadata", share.Metadata)
+	d.Set("share_network_id", share.ShareNetworkID)
+	d.Set("availability_zone", share.AvailabilityZone)
+	d.Set("project_id", share.ProjectID)
+	d.Set("host", share.Host)
+	d.Set("share_server_id", share.ShareServerID)
+	d.Set("has_replicas", share.HasReplicas)
+	d.Set("replication_type", share.ReplicationType)
+	d.Set("status", share.Status)
+
+	if err := d.Set("export_locations", flattenSharedFilesystemShareV2ExportLocations(exportLocations)); err != nil {
+		return fmt.Errorf("Unable to set openstack_sharedfilesystem_share_v2 export_locations: %s", err)
+	}
+
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceSharedFilesystemShareV2Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	sfsClient, err := config.sharedfilesystemV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack sharedfilesystem client: %s", err)
+	}
+
+	sfsClient.Microversion = sharedFilesystemV2MinMicroversion
+
+	var updateOpts shares.UpdateOpts
+	var hasChange bool
+
+	if d.HasChange("name") {
+		hasChange = true
+		name := d.Get("name").(string)
+		updateOpts.DisplayName = &name
+	}
+
+	if d.HasChange("description") {
+		hasChange = true
+		description := d.Get("description").(string)
+		updateOpts.DisplayDescription = &description
+	}
+
+	if d.HasChange("is_public") {
+		hasChange = true
+		isPublic := d.Get("is_public").(bool)
+		updateOpts.IsPublic = &isPublic
+	}
+
+	if hasChange {
+		log.Printf("[DEBUG] openstack_sharedfilesystem_share_v2 %s update options: %#v", d.Id(), updateOpts)
+
+		_, err = shares.Update(sfsClient, d.Id(), updateOpts).Extract()
+		if err != nil {
+			return fmt.Errorf("Error updating openstack_sharedfilesystem_share_v2 %s: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("metadata") {
+		err = sharedFilesystemShareV2SetMetadata(sfsClient, d)
+		if err != nil {
+			return fmt.Errorf("Error updating metadata of openstack_sharedfilesystem_share_v2 %s: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("size") {
+		o, n := d.GetChange("size")
+		oldSize := o.(int)
+		newSize := n.(int)
+
+		pending := []string{"extending"}
+		if newSize > oldSize {
+			extendOpts := shares.ExtendOpts{
+				NewSize: newSize,
+			}
+
+			log.Printf("[DEBUG] Extending openstack_sharedfilesystem_share_v2 %s to %d GB", d.Id(), newSize)
+			err = shares.Extend(sfsClient, d.Id(), extendOpts).ExtractErr()
+			if err != nil {
+				return fmt.Errorf("Error extending openstack_sharedfilesystem_share_v2 %s: %s", d.Id(), err)
+			}
+		} else {
+			pending = []string{"shrinking"}
+			shrinkOpts := shares.ShrinkOpts{
+				NewSize: newSize,
+			}
+
+			log.Printf("[DEBUG] Shrinking openstack_sharedfilesystem_share_v2 %s to %d GB", d.Id(), newSize)
+			err = shares.Shrink(sfsClient, d.Id(), shrinkOpts).ExtractErr()
+			if err != nil {
+				return fmt.Errorf("Error shrinking openstack_sharedfilesystem_share_v2 %s: %s", d.Id(), err)
+			}
+		}
+
+		stateConf := &resource.StateChangeConf{
+			Pending:    pending,
+			Target:     []string{"available"},
+			Refresh:    sharedFilesystemShareV2StateRefreshFunc(sfsClient, d.Id()),
+			Timeout:    d.Timeout(schema.TimeoutUpdate),
+			Delay:      5 * time.Second,
+			MinTimeout: 3 * time.Second,
+		}
+
+		_, err = stateConf.WaitForState()
+		if err != nil {
+			return fmt.Errorf("Error waiting for openstack_sharedfilesystem_share_v2 %s to resize: %s", d.Id(), err)
+		}
+	}
+
+	return resourceSharedFilesystemShareV2Read(d, meta)
+}
+
+func resourceSharedFilesystemShareV2Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	sfsClient, err := config.sharedfilesystemV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack sharedfilesystem client: %s", err)
+	}
+
+	err = shares.Delete(sfsClient, d.Id()).ExtractErr()
+	if err != nil {
+		return CheckDeleted(d, err, "Error deleting openstack_sharedfilesystem_share_v2")
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"available", "deleting"},
+		Target:     []string{"deleted"},
+		Refresh:    sharedFilesystemShareV2StateRefreshFunc(sfsClient, d.Id()),
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, err = stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf("Error waiting for openstack_sharedfilesystem_share_v2 %s to delete: %s", d.Id(), err)
+	}
+
+	return nil
+}
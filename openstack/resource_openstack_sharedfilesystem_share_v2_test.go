@@ -0,0 +1,170 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+
+	"github.com/samuelbernardolip/gophercloud/openstack/sharedfilesystems/v2/shares"
+)
+
+func TestAccSFSV2Share_basic(t *testing.T) {
+	var share shares.Share
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckSFS(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSFSV2ShareDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccSFSV2Share_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSFSV2ShareExists("openstack_sharedfilesystem_share_v2.share_1", &share),
+					resource.TestCheckResourceAttr("openstack_sharedfilesystem_share_v2.share_1", "name", "nfs_share"),
+					resource.TestCheckResourceAttr("openstack_sharedfilesystem_share_v2.share_1", "description", "test share description"),
+					resource.TestCheckResourceAttr("openstack_sharedfilesystem_share_v2.share_1", "share_proto", "NFS"),
+					resource.TestCheckResourceAttr("openstack_sharedfilesystem_share_v2.share_1", "size", "1"),
+					resource.TestCheckResourceAttr("openstack_sharedfilesystem_share_v2.share_1", "metadata.%", "1"),
+					resource.TestCheckResourceAttr("openstack_sharedfilesystem_share_v2.share_1", "metadata.foo", "bar"),
+					resource.TestCheckResourceAttrSet("openstack_sharedfilesystem_share_v2.share_1", "export_locations.0.path"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccSFSV2Share_update,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSFSV2ShareExists("openstack_sharedfilesystem_share_v2.share_1", &share),
+					resource.TestCheckResourceAttr("openstack_sharedfilesystem_share_v2.share_1", "name", "nfs_share_updated"),
+					resource.TestCheckResourceAttr("openstack_sharedfilesystem_share_v2.share_1", "description", ""),
+					resource.TestCheckResourceAttr("openstack_sharedfilesystem_share_v2.share_1", "metadata.%", "1"),
+					resource.TestCheckResourceAttr("openstack_sharedfilesystem_share_v2.share_1", "metadata.hello", "world"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSFSV2Share_resize(t *testing.T) {
+	var share shares.Share
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckSFS(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSFSV2ShareDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccSFSV2Share_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSFSV2ShareExists("openstack_sharedfilesystem_share_v2.share_1", &share),
+					resource.TestCheckResourceAttr("openstack_sharedfilesystem_share_v2.share_1", "size", "1"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccSFSV2Share_extend,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSFSV2ShareExists("openstack_sharedfilesystem_share_v2.share_1", &share),
+					resource.TestCheckResourceAttr("openstack_sharedfilesystem_share_v2.share_1", "size", "2"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccSFSV2Share_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSFSV2ShareExists("openstack_sharedfilesystem_share_v2.share_1", &share),
+					resource.TestCheckResourceAttr("openstack_sharedfilesystem_share_v2.share_1", "size", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckSFSV2ShareDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	sfsClient, err := config.sharedfilesystemV2Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack sharedfilesystem client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "openstack_sharedfilesystem_share_v2" {
+			continue
+		}
+
+		_, err := shares.Get(sfsClient, rs.Primary.ID).Extract()
+		if err == nil {
+			return fmt.Errorf("Share still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckSFSV2ShareExists(n string, share *shares.Share) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		sfsClient, err := config.sharedfilesystemV2Client(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack sharedfilesystem client: %s", err)
+		}
+
+		found, err := shares.Get(sfsClient, rs.Primary.ID).Extract()
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return fmt.Errorf("Share not found")
+		}
+
+		*share = *found
+
+		return nil
+	}
+}
+
+const testAccSFSV2Share_basic = `
+resource "openstack_sharedfilesystem_share_v2" "share_1" {
+  name = "nfs_share"
+  description = "test share description"
+  share_proto = "NFS"
+  size = 1
+
+  metadata {
+    foo = "bar"
+  }
+}
+`
+
+const testAccSFSV2Share_update = `
+resource "openstack_sharedfilesystem_share_v2" "share_1" {
+  name = "nfs_share_updated"
+  share_proto = "NFS"
+  size = 1
+
+  metadata {
+    hello = "world"
+  }
+}
+`
+
+const testAccSFSV2Share_extend = `
+resource "openstack_sharedfilesystem_share_v2" "share_1" {
+  name = "nfs_share"
+  description = "test share description"
+  share_proto = "NFS"
+  size = 2
+
+  metadata {
+    foo = "bar"
+  }
+}
+`
@@ -0,0 +1,265 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/samuelbernardolip/gophercloud/openstack/sharedfilesystems/v2/securityservices"
+	"github.com/samuelbernardolip/gophercloud/openstack/sharedfilesystems/v2/sharenetworks"
+)
+
+func resourceSharedFilesystemShareNetworkV2() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceSharedFilesystemShareNetworkV2Create,
+		Read:   resourceSharedFilesystemShareNetworkV2Read,
+		Update: resourceSharedFilesystemShareNetworkV2Update,
+		Delete: resourceSharedFilesystemShareNetworkV2Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"neutron_net_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"neutron_subnet_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"security_service_ids": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+
+			"project_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"network_type": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"segmentation_id": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"cidr": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"ip_version": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceSharedFilesystemShareNetworkV2Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	sfsClient, err := config.sharedfilesystemV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack sharedfilesystem client: %s", err)
+	}
+
+	sfsClient.Microversion = sharedFilesystemV2MinMicroversion
+
+	createOpts := sharenetworks.CreateOpts{
+		Name:            d.Get("name").(string),
+		Description:     d.Get("description").(string),
+		NeutronNetID:    d.Get("neutron_net_id").(string),
+		NeutronSubnetID: d.Get("neutron_subnet_id").(string),
+	}
+
+	log.Printf("[DEBUG] openstack_sharedfilesystem_sharenetwork_v2 create options: %#v", createOpts)
+
+	shareNetwork, err := sharenetworks.Create(sfsClient, createOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error creating openstack_sharedfilesystem_sharenetwork_v2: %s", err)
+	}
+
+	d.SetId(shareNetwork.ID)
+
+	for _, securityServiceID := range d.Get("security_service_ids").(*schema.Set).List() {
+		addOpts := sharenetworks.AddSecurityServiceOpts{
+			SecurityServiceID: securityServiceID.(string),
+		}
+
+		log.Printf("[DEBUG] Adding security service %s to openstack_sharedfilesystem_sharenetwork_v2 %s", securityServiceID, shareNetwork.ID)
+		_, err = sharenetworks.AddSecurityService(sfsClient, shareNetwork.ID, addOpts).Extract()
+		if err != nil {
+			return fmt.Errorf("Error adding security service %s to openstack_sharedfilesystem_sharenetwork_v2 %s: %s", securityServiceID, shareNetwork.ID, err)
+		}
+	}
+
+	log.Printf("[DEBUG] Created openstack_sharedfilesystem_sharenetwork_v2 %s", shareNetwork.ID)
+
+	return resourceSharedFilesystemShareNetworkV2Read(d, meta)
+}
+
+func resourceSharedFilesystemShareNetworkV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	sfsClient, err := config.sharedfilesystemV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack sharedfilesystem client: %s", err)
+	}
+
+	sfsClient.Microversion = sharedFilesystemV2MinMicroversion
+
+	shareNetwork, err := sharenetworks.Get(sfsClient, d.Id()).Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "Error retrieving openstack_sharedfilesystem_sharenetwork_v2")
+	}
+
+	log.Printf("[DEBUG] Retrieved openstack_sharedfilesystem_sharenetwork_v2 %s: %#v", d.Id(), shareNetwork)
+
+	listOpts := securityservices.ListOpts{
+		ShareNetworkID: d.Id(),
+	}
+
+	allPages, err := securityservices.List(sfsClient, listOpts).AllPages()
+	if err != nil {
+		return fmt.Errorf("Unable to list security services of openstack_sharedfilesystem_sharenetwork_v2 %s: %s", d.Id(), err)
+	}
+
+	allSecurityServices, err := securityservices.ExtractSecurityServices(allPages)
+	if err != nil {
+		return fmt.Errorf("Unable to retrieve security services of openstack_sharedfilesystem_sharenetwork_v2 %s: %s", d.Id(), err)
+	}
+
+	var securityServiceIDs []string
+	for _, securityService := range allSecurityServices {
+		securityServiceIDs = append(securityServiceIDs, securityService.ID)
+	}
+
+	d.Set("name", shareNetwork.Name)
+	d.Set("description", shareNetwork.Description)
+	d.Set("neutron_net_id", shareNetwork.NeutronNetID)
+	d.Set("neutron_subnet_id", shareNetwork.NeutronSubnetID)
+	d.Set("security_service_ids", securityServiceIDs)
+	d.Set("project_id", shareNetwork.ProjectID)
+	d.Set("network_type", shareNetwork.NetworkType)
+	d.Set("segmentation_id", shareNetwork.SegmentationID)
+	d.Set("cidr", shareNetwork.CIDR)
+	d.Set("ip_version", shareNetwork.IPVersion)
+
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceSharedFilesystemShareNetworkV2Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	sfsClient, err := config.sharedfilesystemV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack sharedfilesystem client: %s", err)
+	}
+
+	sfsClient.Microversion = sharedFilesystemV2MinMicroversion
+
+	var updateOpts sharenetworks.UpdateOpts
+	var hasChange bool
+
+	if d.HasChange("name") {
+		hasChange = true
+		name := d.Get("name").(string)
+		updateOpts.Name = &name
+	}
+
+	if d.HasChange("description") {
+		hasChange = true
+		description := d.Get("description").(string)
+		updateOpts.Description = &description
+	}
+
+	// The Neutron network and subnet can only be changed
+	// while the share network isn't used by any share server.
+	if d.HasChange("neutron_net_id") || d.HasChange("neutron_subnet_id") {
+		hasChange = true
+		updateOpts.NeutronNetID = d.Get("neutron_net_id").(string)
+		updateOpts.NeutronSubnetID = d.Get("neutron_subnet_id").(string)
+	}
+
+	if hasChange {
+		log.Printf("[DEBUG] openstack_sharedfilesystem_sharenetwork_v2 %s update options: %#v", d.Id(), updateOpts)
+
+		_, err = sharenetworks.Update(sfsClient, d.Id(), updateOpts).Extract()
+		if err != nil {
+			return fmt.Errorf("Error updating openstack_sharedfilesystem_sharenetwork_v2 %s: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("security_service_ids") {
+		o, n := d.GetChange("security_service_ids")
+		oldSecurityServiceIDs := o.(*schema.Set)
+		newSecurityServiceIDs := n.(*schema.Set)
+
+		for _, securityServiceID := range oldSecurityServiceIDs.Difference(newSecurityServiceIDs).List() {
+			removeOpts := sharenetworks.RemoveSecurityServiceOpts{
+				SecurityServiceID: securityServiceID.(string),
+			}
+
+			log.Printf("[DEBUG] Removing security service %s from openstack_sharedfilesystem_sharenetwork_v2 %s", securityServiceID, d.Id())
+			_, err = sharenetworks.RemoveSecurityService(sfsClient, d.Id(), removeOpts).Extract()
+			if err != nil {
+				return fmt.Errorf("Error removing security service %s from openstack_sharedfilesystem_sharenetwork_v2 %s: %s", securityServiceID, d.Id(), err)
+			}
+		}
+
+		for _, securityServiceID := range newSecurityServiceIDs.Difference(oldSecurityServiceIDs).List() {
+			addOpts := sharenetworks.AddSecurityServiceOpts{
+				SecurityServiceID: securityServiceID.(string),
+			}
+
+			log.Printf("[DEBUG] Adding security service %s to openstack_sharedfilesystem_sharenetwork_v2 %s", securityServiceID, d.Id())
+			_, err = sharenetworks.AddSecurityService(sfsClient, d.Id(), addOpts).Extract()
+			if err != nil {
+				return fmt.Errorf("Error adding security service %s to openstack_sharedfilesystem_sharenetwork_v2 %s: %s", securityServiceID, d.Id(), err)
+			}
+		}
+	}
+
+	return resourceSharedFilesystemShareNetworkV2Read(d, meta)
+}
+
+func resourceSharedFilesystemShareNetworkV2Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	sfsClient, err := config.sharedfilesystemV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack sharedfilesystem client: %s", err)
+	}
+
+	err = sharenetworks.Delete(sfsClient, d.Id()).ExtractErr()
+	if err != nil {
+		return CheckDeleted(d, err, "Error deleting openstack_sharedfilesystem_sharenetwork_v2")
+	}
+
+	return nil
+}
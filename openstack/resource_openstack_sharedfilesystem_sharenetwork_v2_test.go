@@ -0,0 +1,141 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+
+	"github.com/samuelbernardolip/gophercloud/openstack/sharedfilesystems/v2/sharenetworks"
+)
+
+func TestAccSFSV2ShareNetwork_basic(t *testing.T) {
+	var shareNetwork sharenetworks.ShareNetwork
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckSFS(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSFSV2ShareNetworkDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccSFSV2ShareNetwork_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSFSV2ShareNetworkExists("openstack_sharedfilesystem_sharenetwork_v2.sharenetwork_1", &shareNetwork),
+					resource.TestCheckResourceAttr("openstack_sharedfilesystem_sharenetwork_v2.sharenetwork_1", "name", "test_sharenetwork"),
+					resource.TestCheckResourceAttr("openstack_sharedfilesystem_sharenetwork_v2.sharenetwork_1", "security_service_ids.#", "0"),
+					resource.TestCheckResourceAttrPair(
+						"openstack_sharedfilesystem_sharenetwork_v2.sharenetwork_1", "neutron_net_id",
+						"openstack_networking_network_v2.network_1", "id"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccSFSV2ShareNetwork_update,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSFSV2ShareNetworkExists("openstack_sharedfilesystem_sharenetwork_v2.sharenetwork_1", &shareNetwork),
+					resource.TestCheckResourceAttr("openstack_sharedfilesystem_sharenetwork_v2.sharenetwork_1", "name", "test_sharenetwork_updated"),
+					resource.TestCheckResourceAttr("openstack_sharedfilesystem_sharenetwork_v2.sharenetwork_1", "description", "updated share network"),
+					resource.TestCheckResourceAttr("openstack_sharedfilesystem_sharenetwork_v2.sharenetwork_1", "security_service_ids.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckSFSV2ShareNetworkDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	sfsClient, err := config.sharedfilesystemV2Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack sharedfilesystem client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "openstack_sharedfilesystem_sharenetwork_v2" {
+			continue
+		}
+
+		_, err := sharenetworks.Get(sfsClient, rs.Primary.ID).Extract()
+		if err == nil {
+			return fmt.Errorf("Share network still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckSFSV2ShareNetworkExists(n string, shareNetwork *sharenetworks.ShareNetwork) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		sfsClient, err := config.sharedfilesystemV2Client(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack sharedfilesystem client: %s", err)
+		}
+
+		found, err := sharenetworks.Get(sfsClient, rs.Primary.ID).Extract()
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return fmt.Errorf("Share network not found")
+		}
+
+		*shareNetwork = *found
+
+		return nil
+	}
+}
+
+const testAccSFSV2ShareNetwork_network = `
+resource "openstack_networking_network_v2" "network_1" {
+  name = "network_1"
+  admin_state_up = "true"
+}
+
+resource "openstack_networking_subnet_v2" "subnet_1" {
+  name = "subnet_1"
+  cidr = "192.168.199.0/24"
+  ip_version = 4
+  network_id = "${openstack_networking_network_v2.network_1.id}"
+}
+`
+
+var testAccSFSV2ShareNetwork_basic = fmt.Sprintf(`
+%s
+
+resource "openstack_sharedfilesystem_sharenetwork_v2" "sharenetwork_1" {
+  name = "test_sharenetwork"
+  neutron_net_id = "${openstack_networking_network_v2.network_1.id}"
+  neutron_subnet_id = "${openstack_networking_subnet_v2.subnet_1.id}"
+}
+`, testAccSFSV2ShareNetwork_network)
+
+var testAccSFSV2ShareNetwork_update = fmt.Sprintf(`
+%s
+
+resource "openstack_sharedfilesystem_securityservice_v2" "securityservice_1" {
+  name = "securityservice_1"
+  type = "ldap"
+  server = "192.168.199.10"
+  dns_ip = "192.168.199.10"
+}
+
+resource "openstack_sharedfilesystem_sharenetwork_v2" "sharenetwork_1" {
+  name = "test_sharenetwork_updated"
+  description = "updated share network"
+  neutron_net_id = "${openstack_networking_network_v2.network_1.id}"
+  neutron_subnet_id = "${openstack_networking_subnet_v2.subnet_1.id}"
+  security_service_ids = [
+    "${openstack_sharedfilesystem_securityservice_v2.securityservice_1.id}",
+  ]
+}
+`, testAccSFSV2ShareNetwork_network)
@@ -0,0 +1,142 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/samuelbernardolip/gophercloud"
+	"github.com/samuelbernardolip/gophercloud/openstack/sharedfilesystems/v2/shares"
+)
+
+const (
+	// Extend, shrink and the share access actions were renamed in 2.7.
+	sharedFilesystemV2MinMicroversion = "2.7"
+
+	// Export locations report the preferred path since 2.14.
+	sharedFilesystemV2ExportLocationsMicroversion = "2.14"
+
+	// Share access rules return the cephx access key since 2.21.
+	sharedFilesystemV2AccessKeyMicroversion = "2.21"
+)
+
+func expandSharedFilesystemShareV2Metadata(metadata map[string]interface{}) map[string]string {
+	m := make(map[string]string)
+	for k, v := range metadata {
+		m[k] = v.(string)
+	}
+
+	return m
+}
+
+func flattenSharedFilesystemShareV2ExportLocations(exportLocations []shares.ExportLocation) []map[string]interface{} {
+	var locations []map[string]interface{}
+	for _, v := range exportLocations {
+		// Admin only export locations are not usable by end users.
+		if v.IsAdminOnly {
+			continue
+		}
+
+		locations = append(locations, map[string]interface{}{
+			"path":      v.Path,
+			"preferred": v.Preferred,
+		})
+	}
+
+	return locations
+}
+
+func sharedFilesystemShareV2StateRefreshFunc(client *gophercloud.ServiceClient, shareID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		share, err := shares.Get(client, shareID).Extract()
+		if err != nil {
+			if _, ok := err.(gophercloud.ErrDefault404); ok {
+				return share, "deleted", nil
+			}
+
+			return nil, "", err
+		}
+
+		log.Printf("[DEBUG] openstack_sharedfilesystem_share_v2 %s status: %s", shareID, share.Status)
+
+		if strings.Contains(share.Status, "error") {
+			return share, share.Status, fmt.Errorf("openstack_sharedfilesystem_share_v2 %s is in %s status", shareID, share.Status)
+		}
+
+		return share, share.Status, nil
+	}
+}
+
+// sharedFilesystemShareV2SetMetadata sets the new metadata keys of a share
+// and removes the keys which are not present anymore.
+func sharedFilesystemShareV2SetMetadata(client *gophercloud.ServiceClient, d *schema.ResourceData) error {
+	o, n := d.GetChange("metadata")
+	oldMetadata := o.(map[string]interface{})
+	newMetadata := n.(map[string]interface{})
+
+	for k := range oldMetadata {
+		if _, ok := newMetadata[k]; ok {
+			continue
+		}
+
+		log.Printf("[DEBUG] Removing metadata key %s from openstack_sharedfilesystem_share_v2 %s", k, d.Id())
+		err := shares.DeleteMetadatum(client, d.Id(), k).ExtractErr()
+		if err != nil {
+			if _, ok := err.(gophercloud.ErrDefault404); !ok {
+				return err
+			}
+		}
+	}
+
+	if len(newMetadata) > 0 {
+		setOpts := shares.SetMetadataOpts{
+			Metadata: expandSharedFilesystemShareV2Metadata(newMetadata),
+		}
+
+		log.Printf("[DEBUG] openstack_sharedfilesystem_share_v2 %s set metadata options: %#v", d.Id(), setOpts)
+		_, err := shares.SetMetadata(client, d.Id(), setOpts).Extract()
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func sharedFilesystemShareAccessV2Get(client *gophercloud.ServiceClient, shareID, accessID string) (*shares.AccessRight, error) {
+	accessRights, err := shares.ListAccessRights(client, shareID).Extract()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, accessRight := range accessRights {
+		if accessRight.ID == accessID {
+			return &accessRight, nil
+		}
+	}
+
+	return nil, gophercloud.ErrDefault404{}
+}
+
+func sharedFilesystemShareAccessV2StateRefreshFunc(client *gophercloud.ServiceClient, shareID, accessID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		accessRight, err := sharedFilesystemShareAccessV2Get(client, shareID, accessID)
+		if err != nil {
+			if _, ok := err.(gophercloud.ErrDefault404); ok {
+				return accessRight, "deleted", nil
+			}
+
+			return nil, "", err
+		}
+
+		log.Printf("[DEBUG] openstack_sharedfilesystem_share_access_v2 %s state: %s", accessID, accessRight.State)
+
+		if accessRight.State == "error" {
+			return accessRight, accessRight.State, fmt.Errorf("openstack_sharedfilesystem_share_access_v2 %s is in error state", accessID)
+		}
+
+		return accessRight, accessRight.State, nil
+	}
+}
@@ -0,0 +1,46 @@
+package openstack
+
+import (
+	"testing"
+
+	"github.com/samuelbernardolip/gophercloud/openstack/sharedfilesystems/v2/shares"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFlattenSharedFilesystemShareV2ExportLocations(t *testing.T) {
+	exportLocations := []shares.ExportLocation{
+		{
+			Path:        "192.168.199.10:/shares/share-0e4d51d6",
+			IsAdminOnly: false,
+			Preferred:   true,
+		},
+		{
+			Path:        "10.0.0.3:/shares/share-0e4d51d6",
+			IsAdminOnly: true,
+			Preferred:   false,
+		},
+	}
+
+	expected := []map[string]interface{}{
+		{
+			"path":      "192.168.199.10:/shares/share-0e4d51d6",
+			"preferred": true,
+		},
+	}
+
+	actual := flattenSharedFilesystemShareV2ExportLocations(exportLocations)
+	assert.Equal(t, expected, actual)
+}
+
+func TestExpandSharedFilesystemShareV2Metadata(t *testing.T) {
+	metadata := map[string]interface{}{
+		"foo": "bar",
+	}
+
+	expected := map[string]string{
+		"foo": "bar",
+	}
+
+	actual := expandSharedFilesystemShareV2Metadata(metadata)
+	assert.Equal(t, expected, actual)
+}
@@ -0,0 +1,333 @@
+package openstack
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/samuelbernardolip/gophercloud"
+	"github.com/samuelbernardolip/gophercloud/openstack"
+	"github.com/samuelbernardolip/gophercloud/openstack/identity/v3/tokens"
+	"github.com/samuelbernardolip/gophercloud/openstack/utils"
+)
+
+const (
+	// tokenCacheExpiryMargin is how long a cached token has to remain valid
+	// for it to be reused.
+	tokenCacheExpiryMargin = 10 * time.Minute
+
+	// tokenCacheLockTimeout is how long to wait for the lock of the cache.
+	tokenCacheLockTimeout = 60 * time.Second
+
+	// tokenCacheStaleLockAge is the age after which a lock file is assumed
+	// to be left behind by a crashed run.
+	tokenCacheStaleLockAge = 2 * time.Minute
+)
+
+// tokenCacheEntry is a Keystone token and the service catalog it was
+// issued with.
+type tokenCacheEntry struct {
+	TokenID   string                 `json:"token_id"`
+	ExpiresAt time.Time              `json:"expires_at"`
+	Catalog   *tokens.ServiceCatalog `json:"catalog"`
+}
+
+func (e *tokenCacheEntry) valid() bool {
+	return e != nil && e.TokenID != "" && e.Catalog != nil &&
+		time.Now().Add(tokenCacheExpiryMargin).Before(e.ExpiresAt)
+}
+
+// authenticateWithTokenCache authenticates the client with a still valid
+// token from the token cache, or with a new token which is then added to the
+// cache. If a cached token is rejected, the client authenticates again.
+func (c *Config) authenticateWithTokenCache(client *gophercloud.ProviderClient, ao *gophercloud.AuthOptions) error {
+	// A token passed as credential identifies the user by itself, so
+	// it can't be cached without storing a secret.
+	if ao.TokenID != "" {
+		log.Printf("[DEBUG] Not using the OpenStack token cache with token authentication")
+		return openstack.Authenticate(client, *ao)
+	}
+
+	endpoint, err := tokenCacheIdentityEndpoint(client)
+	if err != nil {
+		return err
+	}
+
+	key := tokenCacheKey(ao)
+
+	// The throw-away client is used to issue new tokens without sending
+	// the rejected token or triggering another reauthentication.
+	tac := *client
+	tac.ReauthFunc = nil
+	tac.TokenID = ""
+
+	entry, err := c.tokenCacheEntry(&tac, ao, endpoint, key, "")
+	if err != nil {
+		return err
+	}
+	useTokenCacheEntry(client, entry)
+
+	client.UseTokenLock()
+	client.ReauthFunc = func() error {
+		log.Printf("[DEBUG] OpenStack token was rejected, authenticating again")
+		entry, err := c.tokenCacheEntry(&tac, ao, endpoint, key, client.TokenID)
+		if err != nil {
+			return err
+		}
+		useTokenCacheEntry(client, entry)
+		return nil
+	}
+
+	return nil
+}
+
+// tokenCacheEntry returns a valid cached token other than the rejected one,
+// or issues a new token and stores it in the cache.
+func (c *Config) tokenCacheEntry(client *gophercloud.ProviderClient, ao *gophercloud.AuthOptions, endpoint, key, rejectedTokenID string) (*tokenCacheEntry, error) {
+	unlock, err := lockTokenCache(c.tokenCachePath)
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	cache, err := readTokenCache(c.tokenCachePath)
+	if err != nil {
+		log.Printf("[DEBUG] Unable to read OpenStack token cache %s, ignoring it: %s", c.tokenCachePath, err)
+		cache = make(map[string]*tokenCacheEntry)
+	}
+
+	if entry := cache[key]; entry.valid() && entry.TokenID != rejectedTokenID {
+		log.Printf("[DEBUG] Reusing cached OpenStack token which expires at %s", entry.ExpiresAt)
+		return entry, nil
+	}
+
+	entry, err := newTokenCacheEntry(client, ao, endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	cache[key] = entry
+	if err := writeTokenCache(c.tokenCachePath, cache); err != nil {
+		log.Printf("[DEBUG] Unable to write OpenStack token cache %s: %s", c.tokenCachePath, err)
+	}
+
+	return entry, nil
+}
+
+// tokenCacheIdentityEndpoint returns the Identity endpoint that
+// openstack.Authenticate would use, which must be an Identity v3 endpoint.
+func tokenCacheIdentityEndpoint(client *gophercloud.ProviderClient) (string, error) {
+	versions := []*utils.Version{
+		{ID: "v2.0", Priority: 20, Suffix: "/v2.0/"},
+		{ID: "v3", Priority: 30, Suffix: "/v3/"},
+	}
+
+	chosen, endpoint, err := utils.ChooseVersion(client, versions)
+	if err != nil {
+		return "", err
+	}
+
+	if chosen.ID != "v3" {
+		return "", fmt.Errorf("token_cache_path requires an Identity v3 endpoint, got Identity %s at %s", chosen.ID, endpoint)
+	}
+
+	return endpoint, nil
+}
+
+// newTokenCacheEntry issues a new Identity v3 token.
+func newTokenCacheEntry(client *gophercloud.ProviderClient, ao *gophercloud.AuthOptions, endpoint string) (*tokenCacheEntry, error) {
+	identityClient := &gophercloud.ServiceClient{
+		ProviderClient: client,
+		Endpoint:       endpoint,
+		Type:           "identity",
+	}
+
+	result := tokens.Create(identityClient, ao)
+
+	token, err := result.ExtractToken()
+	if err != nil {
+		return nil, err
+	}
+
+	catalog, err := result.ExtractServiceCatalog()
+	if err != nil {
+		return nil, err
+	}
+
+	log.Printf("[DEBUG] Issued new OpenStack token which expires at %s", token.ExpiresAt)
+
+	return &tokenCacheEntry{
+		TokenID:   token.ID,
+		ExpiresAt: token.ExpiresAt,
+		Catalog:   catalog,
+	}, nil
+}
+
+func useTokenCacheEntry(client *gophercloud.ProviderClient, entry *tokenCacheEntry) {
+	catalog := entry.Catalog
+	client.TokenID = entry.TokenID
+	client.EndpointLocator = func(opts gophercloud.EndpointOpts) (string, error) {
+		return openstack.V3EndpointURL(catalog, opts)
+	}
+}
+
+// tokenCacheKey identifies the user and the scope of a set of auth
+// parameters. Only non-secret parameters are used, so that the key can't be
+// used to guess a password. A cached token therefore remains in use after a
+// password change, until it expires or is rejected.
+func tokenCacheKey(ao *gophercloud.AuthOptions) string {
+	var scope gophercloud.AuthScope
+	if ao.Scope != nil {
+		scope = *ao.Scope
+	}
+
+	b, _ := json.Marshal([]interface{}{
+		ao.IdentityEndpoint,
+		ao.Username,
+		ao.UserID,
+		ao.DomainID,
+		ao.DomainName,
+		ao.TenantID,
+		ao.TenantName,
+		ao.ApplicationCredentialID,
+		ao.ApplicationCredentialName,
+		scope.ProjectID,
+		scope.ProjectName,
+		scope.DomainID,
+		scope.DomainName,
+	})
+
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:])
+}
+
+// lockTokenCache creates a lock file next to the token cache, so parallel
+// runs do not issue tokens at the same time or overwrite each other. It
+// returns a function which releases the lock.
+func lockTokenCache(path string) (func(), error) {
+	lockPath := path + ".lock"
+	deadline := time.Now().Add(tokenCacheLockTimeout)
+
+	for {
+		f, err := os.OpenFile(lockPath, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0600)
+		if err == nil {
+			f.Close()
+			return func() {
+				if err := os.Remove(lockPath); err != nil {
+					log.Printf("[DEBUG] Unable to remove OpenStack token cache lock %s: %s", lockPath, err)
+				}
+			}, nil
+		}
+
+		if !os.IsExist(err) {
+			return nil, fmt.Errorf("Error locking OpenStack token cache %s: %s", path, err)
+		}
+
+		if info, err := os.Stat(lockPath); err == nil && time.Since(info.ModTime()) > tokenCacheStaleLockAge {
+			if err := removeStaleTokenCacheLock(lockPath, info); err != nil {
+				return nil, fmt.Errorf("Error removing stale lock of OpenStack token cache %s: %s", path, err)
+			}
+			continue
+		}
+
+		if time.Now().After(deadline) {
+			return nil, fmt.Errorf("Timeout while waiting for the lock of OpenStack token cache %s", path)
+		}
+
+		time.Sleep(100 * time.Millisecond)
+	}
+}
+
+// removeStaleTokenCacheLock takes a stale lock over by renaming it to a
+// unique name, so that it can't be removed after another run replaced it.
+// If another run replaced it in the meantime, its lock is put back.
+func removeStaleTokenCacheLock(lockPath string, stale os.FileInfo) error {
+	takenPath := fmt.Sprintf("%s.%d.%d", lockPath, os.Getpid(), time.Now().UnixNano())
+	if err := os.Rename(lockPath, takenPath); err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+	defer os.Remove(takenPath)
+
+	taken, err := os.Stat(takenPath)
+	if err != nil {
+		return err
+	}
+
+	// Inodes can be reused, so the lock must still be stale as well.
+	if !os.SameFile(stale, taken) || time.Since(taken.ModTime()) <= tokenCacheStaleLockAge {
+		// Linking fails if yet another run holds the lock by now, which
+		// then keeps it.
+		if err := os.Link(takenPath, lockPath); err != nil && !os.IsExist(err) {
+			return err
+		}
+		return nil
+	}
+
+	log.Printf("[DEBUG] Removed stale OpenStack token cache lock %s", lockPath)
+	return nil
+}
+
+func readTokenCache(path string) (map[string]*tokenCacheEntry, error) {
+	cache := make(map[string]*tokenCacheEntry)
+
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return cache, nil
+		}
+		return nil, err
+	}
+
+	if err := json.Unmarshal(b, &cache); err != nil {
+		return nil, err
+	}
+
+	return cache, nil
+}
+
+// writeTokenCache replaces the token cache atomically and drops tokens
+// which are no longer valid.
+func writeTokenCache(path string, cache map[string]*tokenCacheEntry) error {
+	for key, entry := range cache {
+		if !entry.valid() {
+			delete(cache, key)
+		}
+	}
+
+	b, err := json.Marshal(cache)
+	if err != nil {
+		return err
+	}
+
+	f, err := ioutil.TempFile(filepath.Dir(path), filepath.Base(path)+".tmp")
+	if err != nil {
+		return err
+	}
+
+	if _, err := f.Write(b); err != nil {
+		f.Close()
+		os.Remove(f.Name())
+		return err
+	}
+
+	if err := f.Close(); err != nil {
+		os.Remove(f.Name())
+		return err
+	}
+
+	if err := os.Rename(f.Name(), path); err != nil {
+		os.Remove(f.Name())
+		return err
+	}
+
+	return nil
+}
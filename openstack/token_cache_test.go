@@ -0,0 +1,224 @@
+package openstack
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/samuelbernardolip/gophercloud"
+	"github.com/samuelbernardolip/gophercloud/openstack"
+	"github.com/samuelbernardolip/gophercloud/openstack/identity/v3/tokens"
+)
+
+func TestTokenCacheKey(t *testing.T) {
+	ao1 := &gophercloud.AuthOptions{
+		IdentityEndpoint: "http://localhost:5000/v3",
+		UserID:           "user",
+		Password:         "secret",
+		TenantID:         "project",
+	}
+	ao2 := *ao1
+	ao2.Password = "other"
+	ao3 := *ao1
+	ao3.TenantID = "other"
+
+	assert.Equal(t, tokenCacheKey(ao1), tokenCacheKey(&ao2))
+	assert.NotEqual(t, tokenCacheKey(ao1), tokenCacheKey(&ao3))
+
+	// The key doesn't depend on any secret.
+	ao4 := *ao1
+	ao4.Password = ""
+	assert.Equal(t, tokenCacheKey(ao1), tokenCacheKey(&ao4))
+}
+
+func TestTokenCacheIdentityEndpoint(t *testing.T) {
+	client, err := openstack.NewClient("http://localhost:5000/v3")
+	assert.NoError(t, err)
+
+	endpoint, err := tokenCacheIdentityEndpoint(client)
+	assert.NoError(t, err)
+	assert.Equal(t, "http://localhost:5000/v3/", endpoint)
+
+	client, err = openstack.NewClient("http://localhost:5000/v2.0")
+	assert.NoError(t, err)
+
+	_, err = tokenCacheIdentityEndpoint(client)
+	assert.Error(t, err)
+}
+
+func TestTokenCacheReadWrite(t *testing.T) {
+	dir, err := ioutil.TempDir("", "tf-openstack-token-cache")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "tokens.json")
+
+	cache, err := readTokenCache(path)
+	assert.NoError(t, err)
+	assert.Empty(t, cache)
+
+	valid := &tokenCacheEntry{
+		TokenID:   "valid",
+		ExpiresAt: time.Now().Add(time.Hour).UTC().Truncate(time.Second),
+		Catalog:   &tokens.ServiceCatalog{},
+	}
+	expired := &tokenCacheEntry{
+		TokenID:   "expired",
+		ExpiresAt: time.Now().Add(time.Minute),
+		Catalog:   &tokens.ServiceCatalog{},
+	}
+
+	err = writeTokenCache(path, map[string]*tokenCacheEntry{
+		"valid":   valid,
+		"expired": expired,
+	})
+	assert.NoError(t, err)
+
+	cache, err = readTokenCache(path)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]*tokenCacheEntry{"valid": valid}, cache)
+}
+
+func TestTokenCacheLock(t *testing.T) {
+	dir, err := ioutil.TempDir("", "tf-openstack-token-cache")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "tokens.json")
+
+	unlock, err := lockTokenCache(path)
+	assert.NoError(t, err)
+	_, err = os.Stat(path + ".lock")
+	assert.NoError(t, err)
+
+	unlock()
+	_, err = os.Stat(path + ".lock")
+	assert.True(t, os.IsNotExist(err))
+
+	// A lock left behind by a crashed run is removed.
+	assert.NoError(t, ioutil.WriteFile(path+".lock", nil, 0600))
+	stale := time.Now().Add(-2 * tokenCacheStaleLockAge)
+	assert.NoError(t, os.Chtimes(path+".lock", stale, stale))
+
+	unlock, err = lockTokenCache(path)
+	assert.NoError(t, err)
+	unlock()
+
+	files, err := ioutil.ReadDir(dir)
+	assert.NoError(t, err)
+	assert.Empty(t, files)
+
+	// A lock which was replaced by another run after it was found stale
+	// is kept.
+	assert.NoError(t, ioutil.WriteFile(path+".lock", nil, 0600))
+	assert.NoError(t, os.Chtimes(path+".lock", stale, stale))
+	info, err := os.Stat(path + ".lock")
+	assert.NoError(t, err)
+	assert.NoError(t, os.Remove(path+".lock"))
+	assert.NoError(t, ioutil.WriteFile(path+".lock", []byte("new"), 0600))
+
+	assert.NoError(t, removeStaleTokenCacheLock(path+".lock", info))
+	b, err := ioutil.ReadFile(path + ".lock")
+	assert.NoError(t, err)
+	assert.Equal(t, "new", string(b))
+
+	files, err = ioutil.ReadDir(dir)
+	assert.NoError(t, err)
+	assert.Len(t, files, 1)
+}
+
+func TestConfigAuthenticateWithTokenCache(t *testing.T) {
+	dir, err := ioutil.TempDir("", "tf-openstack-token-cache")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	var issued int
+	rejected := map[string]bool{}
+
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	mux.HandleFunc("/v3/auth/tokens", func(w http.ResponseWriter, r *http.Request) {
+		issued++
+		w.Header().Set("X-Subject-Token", fmt.Sprintf("token-%d", issued))
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprintf(w, `{
+			"token": {
+				"expires_at": "%s",
+				"catalog": [{
+					"id": "1",
+					"name": "nova",
+					"type": "compute",
+					"endpoints": [{
+						"id": "1",
+						"interface": "public",
+						"region": "RegionOne",
+						"region_id": "RegionOne",
+						"url": "%s/compute/"
+					}]
+				}]
+			}
+		}`, time.Now().Add(time.Hour).UTC().Format(time.RFC3339), server.URL)
+	})
+
+	mux.HandleFunc("/compute/servers", func(w http.ResponseWriter, r *http.Request) {
+		if rejected[r.Header.Get("X-Auth-Token")] {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	})
+
+	newConfig := func() *Config {
+		ao := &gophercloud.AuthOptions{
+			IdentityEndpoint: server.URL + "/v3/",
+			UserID:           "user",
+			Password:         "secret",
+			TenantID:         "project",
+		}
+
+		client, err := openstack.NewClient(ao.IdentityEndpoint)
+		assert.NoError(t, err)
+
+		config := &Config{
+			Region:         "RegionOne",
+			tokenCachePath: filepath.Join(dir, "tokens.json"),
+		}
+		assert.NoError(t, config.authenticateWithTokenCache(client, ao))
+		config.OsClient = client
+
+		return config
+	}
+
+	// The first run issues a token and the second one reuses it.
+	config := newConfig()
+	assert.Equal(t, "token-1", config.OsClient.TokenID)
+
+	config = newConfig()
+	assert.Equal(t, "token-1", config.OsClient.TokenID)
+	assert.Equal(t, 1, issued)
+
+	computeClient, err := config.computeV2Client("")
+	assert.NoError(t, err)
+	assert.Equal(t, server.URL+"/compute/", computeClient.Endpoint)
+
+	// A rejected token is replaced by a new one, which is cached as well.
+	rejected["token-1"] = true
+	_, err = computeClient.Get(computeClient.ServiceURL("servers"), nil, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "token-2", config.OsClient.TokenID)
+	assert.Equal(t, 2, issued)
+
+	config = newConfig()
+	assert.Equal(t, "token-2", config.OsClient.TokenID)
+	assert.Equal(t, 2, issued)
+}
@@ -8,7 +8,9 @@ import (
 	"io/ioutil"
 	"log"
 	"net/http"
+	"strconv"
 	"strings"
+	"time"
 
 	snapshots_v2 "github.com/samuelbernardolip/gophercloud/openstack/blockstorage/v2/snapshots"
 	snapshots_v3 "github.com/samuelbernardolip/gophercloud/openstack/blockstorage/v3/snapshots"
@@ -20,6 +22,7 @@ import (
 	"github.com/samuelbernardolip/gophercloud/openstack/networking/v2/extensions/fwaas/rules"
 	"github.com/samuelbernardolip/gophercloud/openstack/networking/v2/extensions/layer3/floatingips"
 	"github.com/samuelbernardolip/gophercloud/openstack/networking/v2/extensions/layer3/routers"
+	qos_policies "github.com/samuelbernardolip/gophercloud/openstack/networking/v2/extensions/qos/policies"
 	"github.com/samuelbernardolip/gophercloud/openstack/networking/v2/extensions/subnetpools"
 	"github.com/samuelbernardolip/gophercloud/openstack/networking/v2/extensions/vpnaas/endpointgroups"
 	"github.com/samuelbernardolip/gophercloud/openstack/networking/v2/extensions/vpnaas/ikepolicies"
@@ -32,10 +35,20 @@ import (
 )
 
 // LogRoundTripper satisfies the http.RoundTripper interface and is used to
-// customize the default http client RoundTripper to allow for logging.
+// customize the default http client RoundTripper to allow for logging and
+// retrying of throttled and transient API errors.
 type LogRoundTripper struct {
 	Rt      http.RoundTripper
 	OsDebug bool
+
+	// MaxRetries is the maximum number of times an idempotent request is
+	// retried. Retrying is disabled if it is zero.
+	MaxRetries int
+
+	// MinRetryBackoff and MaxRetryBackoff bound the exponential backoff
+	// between two attempts, unless the response has a Retry-After header.
+	MinRetryBackoff time.Duration
+	MaxRetryBackoff time.Duration
 }
 
 // RoundTrip performs a round-trip HTTP request and logs relevant information about it.
@@ -64,6 +77,41 @@ func (lrt *LogRoundTripper) RoundTrip(request *http.Request) (*http.Response, er
 	}
 
 	response, err := lrt.Rt.RoundTrip(request)
+
+	for retry := 1; retry <= lrt.MaxRetries && lrt.isRetryable(request, response, err); retry++ {
+		wait := lrt.retryBackoff(response, retry)
+
+		var reason string
+		if response != nil {
+			reason = fmt.Sprintf("response code %d (request ID: %s)", response.StatusCode, openstackRequestID(response.Header))
+
+			// Drain the body, so the underlying connection can be reused.
+			io.Copy(ioutil.Discard, response.Body)
+			response.Body.Close()
+		} else {
+			reason = fmt.Sprintf("error: %s", err)
+		}
+
+		log.Printf("[DEBUG] Retrying OpenStack request %s %s in %s (retry %d of %d) after %s",
+			request.Method, request.URL, wait, retry, lrt.MaxRetries, reason)
+
+		select {
+		case <-request.Context().Done():
+			return nil, request.Context().Err()
+		case <-time.After(wait):
+		}
+
+		retryRequest := *request
+		if request.GetBody != nil {
+			retryRequest.Body, err = request.GetBody()
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		response, err = lrt.Rt.RoundTrip(&retryRequest)
+	}
+
 	if response == nil {
 		return nil, err
 	}
@@ -78,6 +126,81 @@ func (lrt *LogRoundTripper) RoundTrip(request *http.Request) (*http.Response, er
 	return response, err
 }
 
+// isRetryable determines whether a request can safely be sent again after
+// the given response or error. Only idempotent requests with a body that can
+// be replayed are retried.
+func (lrt *LogRoundTripper) isRetryable(request *http.Request, response *http.Response, err error) bool {
+	switch request.Method {
+	case "GET", "HEAD", "OPTIONS", "PUT", "DELETE":
+	default:
+		return false
+	}
+
+	if request.Body != nil && request.Body != http.NoBody && request.GetBody == nil {
+		return false
+	}
+
+	if response == nil {
+		return err != nil && request.Context().Err() == nil
+	}
+
+	// A 409 usually means the resource is busy with another operation. As
+	// only idempotent requests get here, it is safe to send them again.
+	switch response.StatusCode {
+	case http.StatusConflict, http.StatusTooManyRequests, http.StatusBadGateway,
+		http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+
+	return false
+}
+
+// retryBackoff returns how long to wait before the given retry. The
+// Retry-After header of the response is honored if it is set, but never
+// beyond MaxRetryBackoff.
+func (lrt *LogRoundTripper) retryBackoff(response *http.Response, retry int) time.Duration {
+	if response != nil {
+		if v := response.Header.Get("Retry-After"); v != "" {
+			if seconds, err := strconv.Atoi(v); err == nil && seconds >= 0 {
+				return lrt.capRetryBackoff(time.Duration(seconds) * time.Second)
+			}
+			if t, err := http.ParseTime(v); err == nil {
+				if wait := time.Until(t); wait > 0 {
+					return lrt.capRetryBackoff(wait)
+				}
+				return 0
+			}
+		}
+	}
+
+	wait := lrt.MinRetryBackoff
+	for i := 1; i < retry && wait < lrt.MaxRetryBackoff; i++ {
+		wait *= 2
+	}
+
+	return lrt.capRetryBackoff(wait)
+}
+
+// capRetryBackoff limits a wait time to MaxRetryBackoff.
+func (lrt *LogRoundTripper) capRetryBackoff(wait time.Duration) time.Duration {
+	if wait > lrt.MaxRetryBackoff {
+		return lrt.MaxRetryBackoff
+	}
+
+	return wait
+}
+
+// openstackRequestID returns the ID that OpenStack assigned to a request.
+func openstackRequestID(header http.Header) string {
+	for _, h := range []string{"X-Openstack-Request-Id", "X-Compute-Request-Id", "X-Request-Id"} {
+		if v := header.Get(h); v != "" {
+			return v
+		}
+	}
+
+	return "unknown"
+}
+
 // logRequest will log the HTTP Request details.
 // If the body is JSON, it will attempt to be pretty-formatted.
 func (lrt *LogRoundTripper) logRequest(original io.ReadCloser, contentType string) (io.ReadCloser, error) {
@@ -350,6 +473,18 @@ type SubnetPoolCreateOpts struct {
 	ValueSpecs map[string]string `json:"value_specs,omitempty"`
 }
 
+// QoSPolicyCreateOpts represents the attributes used when creating a new QoS policy.
+type QoSPolicyCreateOpts struct {
+	qos_policies.CreateOpts
+	ValueSpecs map[string]string `json:"value_specs,omitempty"`
+}
+
+// ToPolicyCreateMap casts a CreateOpts struct to a map.
+// It overrides policies.ToPolicyCreateMap to add the ValueSpecs field.
+func (opts QoSPolicyCreateOpts) ToPolicyCreateMap() (map[string]interface{}, error) {
+	return BuildRequest(opts, "policy")
+}
+
 // IPSecPolicyCreateOpts represents the attributes used when creating a new IPSec policy.
 type IPSecPolicyCreateOpts struct {
 	ipsecpolicies.CreateOpts
@@ -0,0 +1,148 @@
+package openstack
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func testLogRoundTripperServer(t *testing.T, failures int, status int) (*httptest.Server, *int) {
+	var calls int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+
+		body, err := ioutil.ReadAll(r.Body)
+		assert.NoError(t, err)
+		if r.Method == "PUT" {
+			assert.Equal(t, `{"foo":"bar"}`, string(body))
+		}
+
+		if calls <= failures {
+			w.Header().Set("X-Openstack-Request-Id", "req-1234")
+			w.WriteHeader(status)
+			return
+		}
+
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	return server, &calls
+}
+
+func TestLogRoundTripperRetry(t *testing.T) {
+	server, calls := testLogRoundTripperServer(t, 2, http.StatusServiceUnavailable)
+	defer server.Close()
+
+	client := http.Client{
+		Transport: &LogRoundTripper{
+			Rt:              http.DefaultTransport,
+			MaxRetries:      3,
+			MinRetryBackoff: time.Millisecond,
+			MaxRetryBackoff: 10 * time.Millisecond,
+		},
+	}
+
+	request, err := http.NewRequest("PUT", server.URL, strings.NewReader(`{"foo":"bar"}`))
+	assert.NoError(t, err)
+
+	response, err := client.Do(request)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, response.StatusCode)
+	assert.Equal(t, 3, *calls)
+}
+
+func TestLogRoundTripperRetryExhausted(t *testing.T) {
+	server, calls := testLogRoundTripperServer(t, 5, http.StatusTooManyRequests)
+	defer server.Close()
+
+	client := http.Client{
+		Transport: &LogRoundTripper{
+			Rt:              http.DefaultTransport,
+			MaxRetries:      2,
+			MinRetryBackoff: time.Millisecond,
+			MaxRetryBackoff: 10 * time.Millisecond,
+		},
+	}
+
+	response, err := client.Get(server.URL)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusTooManyRequests, response.StatusCode)
+	assert.Equal(t, 3, *calls)
+}
+
+func TestLogRoundTripperNoRetryPost(t *testing.T) {
+	server, calls := testLogRoundTripperServer(t, 1, http.StatusConflict)
+	defer server.Close()
+
+	client := http.Client{
+		Transport: &LogRoundTripper{
+			Rt:              http.DefaultTransport,
+			MaxRetries:      3,
+			MinRetryBackoff: time.Millisecond,
+			MaxRetryBackoff: 10 * time.Millisecond,
+		},
+	}
+
+	response, err := client.Post(server.URL, "application/json", strings.NewReader(`{"foo":"bar"}`))
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusConflict, response.StatusCode)
+	assert.Equal(t, 1, *calls)
+}
+
+func TestLogRoundTripperRetryConflict(t *testing.T) {
+	server, calls := testLogRoundTripperServer(t, 1, http.StatusConflict)
+	defer server.Close()
+
+	client := http.Client{
+		Transport: &LogRoundTripper{
+			Rt:              http.DefaultTransport,
+			MaxRetries:      3,
+			MinRetryBackoff: time.Millisecond,
+			MaxRetryBackoff: 10 * time.Millisecond,
+		},
+	}
+
+	request, err := http.NewRequest("DELETE", server.URL, nil)
+	assert.NoError(t, err)
+
+	response, err := client.Do(request)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, response.StatusCode)
+	assert.Equal(t, 2, *calls)
+}
+
+func TestLogRoundTripperRetryBackoff(t *testing.T) {
+	lrt := &LogRoundTripper{
+		MinRetryBackoff: time.Second,
+		MaxRetryBackoff: 5 * time.Second,
+	}
+
+	assert.Equal(t, time.Second, lrt.retryBackoff(nil, 1))
+	assert.Equal(t, 2*time.Second, lrt.retryBackoff(nil, 2))
+	assert.Equal(t, 4*time.Second, lrt.retryBackoff(nil, 3))
+	assert.Equal(t, 5*time.Second, lrt.retryBackoff(nil, 4))
+
+	response := &http.Response{
+		Header: http.Header{"Retry-After": []string{"3"}},
+	}
+	assert.Equal(t, 3*time.Second, lrt.retryBackoff(response, 1))
+
+	response.Header.Set("Retry-After", "3600")
+	assert.Equal(t, 5*time.Second, lrt.retryBackoff(response, 1))
+}
+
+func TestOpenstackRequestID(t *testing.T) {
+	header := http.Header{}
+	assert.Equal(t, "unknown", openstackRequestID(header))
+
+	header.Set("X-Compute-Request-Id", "req-compute")
+	assert.Equal(t, "req-compute", openstackRequestID(header))
+
+	header.Set("X-Openstack-Request-Id", "req-openstack")
+	assert.Equal(t, "req-openstack", openstackRequestID(header))
+}